@@ -2,12 +2,17 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
+	"os"
 	"time"
 
+	"github.com/kpauljoseph/test/internal/tracing"
 	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,10 +20,30 @@ const (
 	address = "localhost:50051"
 )
 
+var (
+	token       = flag.String("token", os.Getenv("BLOG_TOKEN"), "bearer token sent with every request (defaults to $BLOG_TOKEN)")
+	apiKey      = flag.String("api-key", os.Getenv("BLOG_API_KEY"), "API key sent with every request (defaults to $BLOG_API_KEY)")
+	traceOutput = flag.String("trace-output", "", "write trace spans to stdout or to this file (empty to disable)")
+)
+
 func main() {
+	flag.Parse()
+
 	log.Println("Starting gRPC blog client...")
 
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	shutdownTracing, err := tracing.Setup(tracing.Config{
+		ServiceName: "blog-client",
+		Output:      *traceOutput,
+	})
+	if err != nil {
+		log.Fatalf("Failed to configure tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
@@ -28,6 +53,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	} else if *apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", *apiKey)
+	}
+
 	log.Printf("Connected to server at %s", address)
 
 	log.Println("\n1. Creating blog posts...")
@@ -47,7 +78,7 @@ func main() {
 
 	log.Println("\n4. Updating blog post...")
 	if post1 != nil {
-		updatePost(ctx, client, post1.PostId, "Go Programming Advanced Techniques", "Advanced techniques for Go development...", "John Doe Updated", []string{"golang", "advanced", "techniques"})
+		updatePost(ctx, client, post1.PostId, "Go Programming Advanced Techniques", "Advanced techniques for Go development...", []string{"golang", "advanced", "techniques"})
 	}
 
 	log.Println("\n5. Deleting blog post...")
@@ -88,7 +119,7 @@ func createPost(ctx context.Context, client proto.BlogServiceClient, title, cont
 	log.Printf("  PostID: %s", post.PostId)
 	log.Printf("  Title: %s", post.Title)
 	log.Printf("  Content: %s", post.Content)
-	log.Printf("  Author: %s", post.Author)
+	log.Printf("  Author: %s (%s)", post.Author, post.AuthorId)
 	log.Printf("  Publication Date: %s", post.PublicationDate.AsTime().Format(time.RFC3339))
 	log.Printf("  Tags: %v", post.Tags)
 
@@ -118,19 +149,18 @@ func readPost(ctx context.Context, client proto.BlogServiceClient, postID string
 	log.Printf("  PostID: %s", post.PostId)
 	log.Printf("  Title: %s", post.Title)
 	log.Printf("  Content: %s", post.Content)
-	log.Printf("  Author: %s", post.Author)
+	log.Printf("  Author: %s (%s)", post.Author, post.AuthorId)
 	log.Printf("  Publication Date: %s", post.PublicationDate.AsTime().Format(time.RFC3339))
 	log.Printf("  Tags: %v", post.Tags)
 }
 
-func updatePost(ctx context.Context, client proto.BlogServiceClient, postID, title, content, author string, tags []string) {
+func updatePost(ctx context.Context, client proto.BlogServiceClient, postID, title, content string, tags []string) {
 	log.Printf("Updating post: postID='%s'", postID)
 
 	req := &proto.UpdatePostRequest{
 		PostId:  postID,
 		Title:   title,
 		Content: content,
-		Author:  author,
 		Tags:    tags,
 	}
 
@@ -150,7 +180,7 @@ func updatePost(ctx context.Context, client proto.BlogServiceClient, postID, tit
 	log.Printf("  PostID: %s", post.PostId)
 	log.Printf("  Title: %s", post.Title)
 	log.Printf("  Content: %s", post.Content)
-	log.Printf("  Author: %s", post.Author)
+	log.Printf("  Author: %s (%s)", post.Author, post.AuthorId)
 	log.Printf("  Publication Date: %s", post.PublicationDate.AsTime().Format(time.RFC3339))
 	log.Printf("  Tags: %v", post.Tags)
 }
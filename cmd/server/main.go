@@ -1,20 +1,97 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
 	"log"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/broker"
+	"github.com/kpauljoseph/test/internal/feed"
+	"github.com/kpauljoseph/test/internal/idempotency"
+	"github.com/kpauljoseph/test/internal/logging"
+	"github.com/kpauljoseph/test/internal/metrics"
+	"github.com/kpauljoseph/test/internal/moderation"
+	"github.com/kpauljoseph/test/internal/outbox"
+	"github.com/kpauljoseph/test/internal/ratelimit"
+	"github.com/kpauljoseph/test/internal/recovery"
 	"github.com/kpauljoseph/test/internal/server"
 	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/tagging"
+	"github.com/kpauljoseph/test/internal/tracing"
+	"github.com/kpauljoseph/test/internal/trending"
+	"github.com/kpauljoseph/test/internal/validation"
+	"github.com/kpauljoseph/test/internal/webhook"
 	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
 const (
 	port = ":50051"
+	// brokerSubjectPrefix starts the broker subject of every post event.
+	brokerSubjectPrefix = "blog"
+)
+
+var (
+	jwtHMACKeyFile = flag.String("jwt-hmac-key-file", "", "file containing the shared HS256 secret")
+	jwtRSAKeyFile  = flag.String("jwt-rsa-key-file", "", "PEM file containing the RS256 public key")
+	jwtJWKSFile    = flag.String("jwt-jwks-file", "", "JSON Web Key Set file with RS256 public keys")
+	jwtIssuer      = flag.String("jwt-issuer", "", "required token issuer (optional)")
+	jwtAudience    = flag.String("jwt-audience", "", "required token audience (optional)")
+	policyFile     = flag.String("authz-policy-file", "", "JSON role policy file (defaults to the built-in policy)")
+	rateLimitFile  = flag.String("ratelimit-config", "", "JSON rate limit and quota config file (defaults to the built-in limits)")
+	logFormat      = flag.String("log-format", "text", "log output format: text or json")
+	logLevel       = flag.String("log-level", "info", "minimum log level: debug, info, warn or error")
+	metricsAddr    = flag.String("metrics-addr", ":9090", "address of the Prometheus /metrics endpoint (empty to disable)")
+	traceOutput    = flag.String("trace-output", "", "write trace spans to stdout or to this file (empty to disable)")
+	moderationFile = flag.String("moderation-config", "", "JSON comment auto-moderation rules file (defaults to the built-in rules)")
+	reactionTypes  = flag.String("reaction-types", strings.Join(server.DefaultReactionTypes, ","), "comma-separated reaction types accepted by ReactToPost")
+	viewWindow     = flag.Duration("view-window", server.DefaultViewWindow, "how long repeated views of a post by the same viewer are ignored")
+	viewsOnRead    = flag.Bool("count-views-on-read", false, "record a view for every ReadPost call")
+	trendingFile   = flag.String("trending-config", "", "JSON trending score config file (defaults to the built-in weights and windows)")
+	dupThreshold   = flag.Float64("duplicate-threshold", storage.DefaultDuplicateThreshold, "content similarity above 0 and up to 1 at which posts count as near duplicates")
+	dupPolicy      = flag.String("duplicate-policy", string(server.DuplicateWarn), "what CreatePost does with near duplicates: warn, reject or ignore")
+	tagConfigFile  = flag.String("tag-config", "", "JSON tag normalization config file (defaults to trimming and case folding)")
+	feedCacheTTL   = flag.Duration("feed-cache-ttl", feed.DefaultCacheTTL, "how long a merged home feed is reused before new posts show up in it")
+	webhookFile    = flag.String("webhook-config", "", "JSON webhook retry and delivery config file (defaults to the built-in retries)")
+	eventFile      = flag.String("event-file", "", "append post events to this file as JSON lines (empty to disable)")
+	brokerAddr     = flag.String("broker-addr", "", "address of the NATS-compatible event broker, such as 127.0.0.1:4222 (empty to disable)")
+	brokerToken    = flag.String("broker-token-file", "", "file containing the token broker clients must send; required unless -broker-addr is a loopback address")
+	idempotencyTTL = flag.Duration("idempotency-window", 24*time.Hour, "how long CreatePost results are remembered by idempotency key (0 to disable)")
 )
 
 func main() {
+	flag.Parse()
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(*logLevel)); err != nil {
+		log.Fatalf("Invalid log level: %v", err)
+	}
+	logger, err := logging.New(os.Stderr, *logFormat, level)
+	if err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
+	}
+	slog.SetDefault(logger)
+
+	shutdownTracing, err := tracing.Setup(tracing.Config{
+		ServiceName: "blog-server",
+		Output:      *traceOutput,
+	})
+	if err != nil {
+		log.Fatalf("Failed to configure tracing: %v", err)
+	}
+
 	log.Printf("Starting gRPC blog server on port %s", port)
 
 	lis, err := net.Listen("tcp", port)
@@ -22,11 +99,172 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	storage := storage.NewMemoryStorage()
-	blogServer := server.NewBlogServer(storage)
+	trendingConfig, err := loadTrendingConfig()
+	if err != nil {
+		log.Fatalf("Failed to load trending config: %v", err)
+	}
+	duplicatePolicy, err := server.ParseDuplicatePolicy(*dupPolicy)
+	if err != nil {
+		log.Fatalf("Invalid duplicate policy: %v", err)
+	}
+	if *dupThreshold <= 0 || *dupThreshold > 1 {
+		log.Fatalf("Invalid duplicate threshold %v: must be greater than 0 and at most 1", *dupThreshold)
+	}
+	storage := storage.NewMemoryStorage(
+		storage.WithTrending(trendingConfig),
+		storage.WithDuplicateThreshold(*dupThreshold),
+	)
+
+	unary := []grpc.UnaryServerInterceptor{logging.UnaryServerInterceptor(logger)}
+	stream := []grpc.StreamServerInterceptor{logging.StreamServerInterceptor(logger)}
+
+	var panicHook recovery.Hook
+	if *metricsAddr != "" {
+		m := metrics.New()
+		m.RegisterStorage(storage)
+		unary = append(unary, metrics.UnaryServerInterceptor(m))
+		stream = append(stream, metrics.StreamServerInterceptor(m))
+		panicHook = m.RecordPanic
+		go serveMetrics(*metricsAddr, m)
+	}
+	unary = append(unary, recovery.UnaryServerInterceptor(panicHook))
+	stream = append(stream, recovery.StreamServerInterceptor(panicHook))
+	var serverOpts []server.Option
+	var policy *authz.Policy
+	var adminServer *server.AdminServer
+	verifier, err := newVerifier()
+	if err != nil {
+		log.Fatalf("Failed to configure authentication: %v", err)
+	}
+	if verifier != nil {
+		policy, err = loadPolicy()
+		if err != nil {
+			log.Fatalf("Failed to load authorization policy: %v", err)
+		}
+		apiKeys := auth.NewAPIKeyStore()
+		authenticator := auth.NewAuthenticator(verifier, apiKeys)
+		unary = append(unary,
+			auth.UnaryServerInterceptor(authenticator),
+			authz.UnaryServerInterceptor(policy),
+		)
+		stream = append(stream,
+			auth.StreamServerInterceptor(authenticator),
+			authz.StreamServerInterceptor(policy),
+		)
+		serverOpts = append(serverOpts, server.WithPolicy(policy))
+		adminServer = server.NewAdminServer(apiKeys, policy, storage)
+		log.Println("JWT and API key authentication with role-based authorization enabled")
+	} else {
+		log.Println("WARNING: no JWT keys configured, authentication, the admin service and webhooks are disabled")
+	}
+
+	rateLimits, err := loadRateLimits()
+	if err != nil {
+		log.Fatalf("Failed to load rate limit config: %v", err)
+	}
+	limiter := ratelimit.NewLimiter(rateLimits)
+	unary = append(unary,
+		ratelimit.UnaryServerInterceptor(limiter),
+		validation.UnaryServerInterceptor(),
+	)
+	stream = append(stream,
+		ratelimit.StreamServerInterceptor(limiter),
+		validation.StreamServerInterceptor(),
+	)
+
+	if *idempotencyTTL > 0 {
+		serverOpts = append(serverOpts, server.WithIdempotency(idempotency.NewStore(*idempotencyTTL)))
+	}
+
+	tagConfig, err := loadTagConfig()
+	if err != nil {
+		log.Fatalf("Failed to load tag config: %v", err)
+	}
+	tagNormalizer := tagging.NewNormalizer(tagConfig)
+
+	webhookConfig, err := loadWebhookConfig()
+	if err != nil {
+		log.Fatalf("Failed to load webhook config: %v", err)
+	}
+	dispatcher := webhook.NewDispatcher(webhookConfig)
+
+	// Post changes reach the sinks through the storage outbox, so an
+	// event is only lost if the change that caused it is.
+	relay := outbox.NewRelay(storage)
+	relay.AddSink("webhooks", dispatcher)
+	if *eventFile != "" {
+		fileSink, err := outbox.NewFileSink(*eventFile)
+		if err != nil {
+			log.Fatalf("Failed to open event file: %v", err)
+		}
+		defer fileSink.Close()
+		relay.AddSink("file", fileSink)
+	}
+	if *brokerAddr != "" {
+		token, err := loadBrokerToken(*brokerAddr)
+		if err != nil {
+			log.Fatalf("Failed to configure broker: %v", err)
+		}
+		b := broker.New()
+		go serveBroker(*brokerAddr, b, token)
+		relay.AddSink("broker", outbox.NewBrokerSink(b, brokerSubjectPrefix))
+	}
+
+	background, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go dispatcher.Run(background)
+	go relay.Run(background)
+	go storage.RunPublisher(background)
+
+	serverOpts = append(serverOpts,
+		server.WithTagNormalizer(tagNormalizer),
+		server.WithReactionTypes(splitList(*reactionTypes)...),
+		server.WithViewWindow(*viewWindow),
+		server.WithDuplicatePolicy(duplicatePolicy),
+	)
+	if *viewsOnRead {
+		serverOpts = append(serverOpts, server.WithViewsOnRead())
+	}
+
+	blogServer := server.NewBlogServer(storage, serverOpts...)
+	moderationRules, err := loadModerationRules()
+	if err != nil {
+		log.Fatalf("Failed to load moderation config: %v", err)
+	}
+	commentServer := server.NewCommentServer(storage, policy, moderation.NewModerator(moderationRules))
+	feedCache := feed.NewCache(*feedCacheTTL, feed.DefaultCacheUsers)
+	tagServer := server.NewTagServer(storage, tagNormalizer, feedCache)
+	categoryServer := server.NewCategoryServer(storage)
+	seriesServer := server.NewSeriesServer(storage, policy)
+	authorServer := server.NewAuthorServer(storage, policy)
+	feedServer := server.NewFeedServer(storage, tagNormalizer, feedCache)
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
 	proto.RegisterBlogServiceServer(s, blogServer)
+	proto.RegisterCommentServiceServer(s, commentServer)
+	proto.RegisterTagServiceServer(s, tagServer)
+	proto.RegisterCategoryServiceServer(s, categoryServer)
+	proto.RegisterSeriesServiceServer(s, seriesServer)
+	proto.RegisterAuthorServiceServer(s, authorServer)
+	proto.RegisterFeedServiceServer(s, feedServer)
+	if adminServer != nil {
+		proto.RegisterAdminServiceServer(s, adminServer)
+		// Webhooks receive every post event, so like the admin service
+		// they are only offered to authenticated admins.
+		proto.RegisterWebhookServiceServer(s, server.NewWebhookServer(dispatcher))
+	}
+
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		log.Println("Shutting down...")
+		s.GracefulStop()
+	}()
 
 	log.Printf("Blog server listening at %v", lis.Addr())
 	log.Println("Server ready to accept connections...")
@@ -34,4 +272,142 @@ func main() {
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
-}
\ No newline at end of file
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("Failed to flush traces: %v", err)
+	}
+}
+
+// newVerifier builds a token verifier from the key flags. It returns nil
+// when no keys are configured.
+func newVerifier() (*auth.Verifier, error) {
+	var keys auth.KeySet
+	var err error
+
+	if *jwtHMACKeyFile != "" {
+		if keys.HMAC, err = auth.LoadHMACKey(*jwtHMACKeyFile); err != nil {
+			return nil, err
+		}
+	}
+	if *jwtRSAKeyFile != "" {
+		if keys.RSA, err = auth.LoadRSAPublicKey(*jwtRSAKeyFile); err != nil {
+			return nil, err
+		}
+	}
+	if *jwtJWKSFile != "" {
+		if keys.RSAByID, err = auth.LoadJWKS(*jwtJWKSFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if keys.HMAC == nil && keys.RSA == nil && keys.RSAByID == nil {
+		return nil, nil
+	}
+	return auth.NewVerifier(keys, *jwtIssuer, *jwtAudience)
+}
+
+func loadPolicy() (*authz.Policy, error) {
+	if *policyFile == "" {
+		return authz.DefaultPolicy(), nil
+	}
+	return authz.LoadPolicy(*policyFile)
+}
+
+func loadRateLimits() (*ratelimit.Config, error) {
+	if *rateLimitFile == "" {
+		return ratelimit.DefaultConfig(), nil
+	}
+	return ratelimit.LoadConfig(*rateLimitFile)
+}
+
+func loadTrendingConfig() (*trending.Config, error) {
+	if *trendingFile == "" {
+		return trending.DefaultConfig(), nil
+	}
+	return trending.LoadConfig(*trendingFile)
+}
+
+func loadTagConfig() (*tagging.Config, error) {
+	if *tagConfigFile == "" {
+		return tagging.DefaultConfig(), nil
+	}
+	return tagging.LoadConfig(*tagConfigFile)
+}
+
+func loadWebhookConfig() (*webhook.Config, error) {
+	if *webhookFile == "" {
+		return webhook.DefaultConfig(), nil
+	}
+	return webhook.LoadConfig(*webhookFile)
+}
+
+func loadModerationRules() (*moderation.Config, error) {
+	if *moderationFile == "" {
+		return moderation.DefaultConfig(), nil
+	}
+	return moderation.LoadConfig(*moderationFile)
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// loadBrokerToken reads the token broker clients must authenticate with.
+// Without one the broker is only served on loopback addresses.
+func loadBrokerToken(addr string) (string, error) {
+	if *brokerToken == "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return "", fmt.Errorf("parse broker address: %w", err)
+		}
+		if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			return "", fmt.Errorf("-broker-token-file is required to serve the broker on %q, which is not a loopback address", addr)
+		}
+		return "", nil
+	}
+	data, err := os.ReadFile(*brokerToken)
+	if err != nil {
+		return "", fmt.Errorf("read broker token: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("broker token file %s is empty", *brokerToken)
+	}
+	return token, nil
+}
+
+// serveBroker runs the event broker. Post events are published on
+// subjects such as "blog.post.created", which clients may only subscribe to.
+func serveBroker(addr string, b *broker.Broker, token string) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen for broker clients: %v", err)
+	}
+	log.Printf("Serving NATS-compatible event broker at %s", l.Addr())
+	srv := broker.NewServer(b,
+		broker.WithAuthToken(token),
+		broker.WithReadOnlySubjects(brokerSubjectPrefix+".>"),
+	)
+	if err := srv.Serve(l); err != nil {
+		log.Fatalf("Failed to serve broker: %v", err)
+	}
+}
+
+func serveMetrics(addr string, m *metrics.Metrics) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+
+	log.Printf("Serving metrics at http://%s/metrics", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Fatalf("Failed to serve metrics: %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const apiKeyPrefix = "blog_"
+
+// APIKey is the stored metadata of an API key. The key itself is never
+// kept; only its SHA-256 hash is.
+type APIKey struct {
+	ID        string
+	Name      string
+	Scopes    []string
+	CreatedBy string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	Revoked   bool
+
+	hash string
+}
+
+// Expired reports whether the key has an expiry that lies before now.
+func (k *APIKey) Expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
+
+// APIKeyStore keeps hashed API keys in memory.
+type APIKeyStore struct {
+	mu     sync.RWMutex
+	keys   map[string]*APIKey
+	byHash map[string]*APIKey
+	now    func() time.Time
+}
+
+func NewAPIKeyStore() *APIKeyStore {
+	return &APIKeyStore{
+		keys:   make(map[string]*APIKey),
+		byHash: make(map[string]*APIKey),
+		now:    time.Now,
+	}
+}
+
+// Create generates a new key and returns its metadata together with the
+// plaintext key, which the caller must hand to the client exactly once.
+// A zero expiresAt creates a key that never expires.
+func (s *APIKeyStore) Create(name string, scopes []string, createdBy string, expiresAt time.Time) (*APIKey, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("generate api key: %w", err)
+	}
+	plaintext := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	key := &APIKey{
+		ID:        uuid.New().String(),
+		Name:      name,
+		Scopes:    append([]string(nil), scopes...),
+		CreatedBy: createdBy,
+		CreatedAt: s.now(),
+		ExpiresAt: expiresAt,
+		hash:      hashAPIKey(plaintext),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key.ID] = key
+	s.byHash[key.hash] = key
+
+	copied := *key
+	return &copied, plaintext, nil
+}
+
+// List returns the metadata of all keys, including revoked ones, ordered by
+// creation time.
+func (s *APIKeyStore) List() []*APIKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]*APIKey, 0, len(s.keys))
+	for _, k := range s.keys {
+		copied := *k
+		keys = append(keys, &copied)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys
+}
+
+// Revoke disables a key. Revoked keys stay listed so their history is kept.
+func (s *APIKeyStore) Revoke(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, exists := s.keys[id]
+	if !exists {
+		return fmt.Errorf("api key with ID %s not found", id)
+	}
+	key.Revoked = true
+	return nil
+}
+
+// Authenticate returns the identity for a plaintext key. The key's scopes
+// become the identity's roles.
+func (s *APIKeyStore) Authenticate(plaintext string) (*Identity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, exists := s.byHash[hashAPIKey(plaintext)]
+	if !exists {
+		return nil, errors.New("unknown api key")
+	}
+	if key.Revoked {
+		return nil, errors.New("api key has been revoked")
+	}
+	if key.Expired(s.now()) {
+		return nil, errors.New("api key has expired")
+	}
+
+	return &Identity{
+		Subject: "apikey:" + key.ID,
+		Name:    key.Name,
+		Roles:   append([]string(nil), key.Scopes...),
+	}, nil
+}
+
+func hashAPIKey(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
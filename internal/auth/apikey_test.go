@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIKeyStore_CreateAndAuthenticate(t *testing.T) {
+	store := NewAPIKeyStore()
+
+	key, plaintext, err := store.Create("batch-job", []string{"author"}, "admin-1", time.Time{})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !strings.HasPrefix(plaintext, apiKeyPrefix) {
+		t.Errorf("Create() key = %q, want prefix %q", plaintext, apiKeyPrefix)
+	}
+	if key.hash == plaintext || key.hash != hashAPIKey(plaintext) {
+		t.Error("Create() should only store the key hash")
+	}
+
+	id, err := store.Authenticate(plaintext)
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if id.Subject != "apikey:"+key.ID {
+		t.Errorf("Authenticate() subject = %v, want apikey:%v", id.Subject, key.ID)
+	}
+	if len(id.Roles) != 1 || id.Roles[0] != "author" {
+		t.Errorf("Authenticate() roles = %v, want [author]", id.Roles)
+	}
+
+	if _, err := store.Authenticate(plaintext + "x"); err == nil {
+		t.Error("Authenticate() expected error for unknown key")
+	}
+}
+
+func TestAPIKeyStore_Revoke(t *testing.T) {
+	store := NewAPIKeyStore()
+
+	key, plaintext, err := store.Create("batch-job", []string{"author"}, "admin-1", time.Time{})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := store.Revoke(key.ID); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if _, err := store.Authenticate(plaintext); err == nil {
+		t.Error("Authenticate() expected error for revoked key")
+	}
+	if err := store.Revoke("non-existent-id"); err == nil {
+		t.Error("Revoke() expected error for unknown key")
+	}
+
+	keys := store.List()
+	if len(keys) != 1 || !keys[0].Revoked {
+		t.Errorf("List() = %+v, want one revoked key", keys)
+	}
+}
+
+func TestAPIKeyStore_Expiry(t *testing.T) {
+	store := NewAPIKeyStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	_, plaintext, err := store.Create("short-lived", []string{"reader"}, "admin-1", now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := store.Authenticate(plaintext); err != nil {
+		t.Errorf("Authenticate() before expiry error = %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err := store.Authenticate(plaintext); err == nil {
+		t.Error("Authenticate() expected error after expiry")
+	}
+}
@@ -0,0 +1,23 @@
+package auth
+
+import "context"
+
+// Identity describes the authenticated caller of an RPC.
+type Identity struct {
+	Subject string
+	Name    string
+	Roles   []string
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying the given identity.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity stored in ctx, if any.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok && id != nil
+}
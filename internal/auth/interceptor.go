@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	apiKeyHeader        = "x-api-key"
+)
+
+// Authenticator resolves the caller of an RPC from either a bearer token in
+// the authorization metadata or an API key in the x-api-key metadata.
+// Either source may be nil to disable it.
+type Authenticator struct {
+	verifier *Verifier
+	apiKeys  *APIKeyStore
+}
+
+func NewAuthenticator(verifier *Verifier, apiKeys *APIKeyStore) *Authenticator {
+	return &Authenticator{
+		verifier: verifier,
+		apiKeys:  apiKeys,
+	}
+}
+
+// UnaryServerInterceptor rejects unary calls without valid credentials and
+// stores the caller's identity in the handler context.
+func UnaryServerInterceptor(a *Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(a *Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(authorizationHeader); len(values) > 0 {
+		return a.authenticateBearer(ctx, values[0])
+	}
+	if values := md.Get(apiKeyHeader); len(values) > 0 {
+		return a.authenticateAPIKey(ctx, values[0])
+	}
+	return nil, status.Error(codes.Unauthenticated, "missing authorization or x-api-key metadata")
+}
+
+func (a *Authenticator) authenticateBearer(ctx context.Context, value string) (context.Context, error) {
+	if a.verifier == nil {
+		return nil, status.Error(codes.Unauthenticated, "bearer tokens are not accepted")
+	}
+
+	scheme, token, ok := strings.Cut(value, " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata must be a bearer token")
+	}
+
+	id, err := a.verifier.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+	return NewContext(ctx, id), nil
+}
+
+func (a *Authenticator) authenticateAPIKey(ctx context.Context, key string) (context.Context, error) {
+	if a.apiKeys == nil {
+		return nil, status.Error(codes.Unauthenticated, "api keys are not accepted")
+	}
+
+	id, err := a.apiKeys.Authenticate(strings.TrimSpace(key))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid api key: %v", err)
+	}
+	return NewContext(ctx, id), nil
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	verifier, err := NewVerifier(KeySet{HMAC: testHMACKey}, "", "")
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+	apiKeys := NewAPIKeyStore()
+	_, apiKey, err := apiKeys.Create("batch", []string{"author"}, "admin", time.Time{})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	interceptor := UnaryServerInterceptor(NewAuthenticator(verifier, apiKeys))
+	info := &grpc.UnaryServerInfo{FullMethod: "/blog.BlogService/CreatePost"}
+
+	tests := []struct {
+		name        string
+		md          metadata.MD
+		wantCode    codes.Code
+		wantSubject string
+	}{
+		{
+			name:     "missing metadata",
+			md:       nil,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "wrong scheme",
+			md:       metadata.Pairs("authorization", "Basic dXNlcjpwYXNz"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "invalid token",
+			md:       metadata.Pairs("authorization", "Bearer garbage"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:        "valid token",
+			md:          metadata.Pairs("authorization", "Bearer "+signHS256(t, testHMACKey, validClaims("user-1"))),
+			wantCode:    codes.OK,
+			wantSubject: "user-1",
+		},
+		{
+			name:     "invalid api key",
+			md:       metadata.Pairs("x-api-key", "blog_nope"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:        "valid api key",
+			md:          metadata.Pairs("x-api-key", apiKey),
+			wantCode:    codes.OK,
+			wantSubject: "apikey:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var gotID *Identity
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotID, _ = FromContext(ctx)
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, nil, info, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("interceptor code = %v, want %v (err=%v)", code, tt.wantCode, err)
+			}
+			if tt.wantCode == codes.OK {
+				if gotID == nil || !strings.HasPrefix(gotID.Subject, tt.wantSubject) {
+					t.Errorf("handler identity = %+v, want subject %s", gotID, tt.wantSubject)
+				}
+			} else if gotID != nil {
+				t.Error("handler should not run for rejected calls")
+			}
+		})
+	}
+}
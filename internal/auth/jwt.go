@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type claims struct {
+	jwt.RegisteredClaims
+	Name  string   `json:"name,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Verifier validates HS256 and RS256 bearer tokens and turns their claims
+// into an Identity.
+type Verifier struct {
+	keys   KeySet
+	parser *jwt.Parser
+}
+
+// NewVerifier creates a Verifier for the given keys. The issuer and audience
+// are only checked when non-empty.
+func NewVerifier(keys KeySet, issuer, audience string) (*Verifier, error) {
+	if len(keys.HMAC) == 0 && keys.RSA == nil && len(keys.RSAByID) == 0 {
+		return nil, errors.New("no verification keys configured")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"HS256", "RS256"}),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+
+	return &Verifier{
+		keys:   keys,
+		parser: jwt.NewParser(opts...),
+	}, nil
+}
+
+// Verify checks the token signature and standard claims and returns the
+// identity of its subject.
+func (v *Verifier) Verify(token string) (*Identity, error) {
+	var c claims
+	if _, err := v.parser.ParseWithClaims(token, &c, v.keyFunc); err != nil {
+		return nil, err
+	}
+	if c.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	return &Identity{
+		Subject: c.Subject,
+		Name:    c.Name,
+		Roles:   c.Roles,
+	}, nil
+}
+
+func (v *Verifier) keyFunc(t *jwt.Token) (interface{}, error) {
+	switch t.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(v.keys.HMAC) == 0 {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return v.keys.HMAC, nil
+	case *jwt.SigningMethodRSA:
+		if kid, ok := t.Header["kid"].(string); ok && kid != "" {
+			key, ok := v.keys.RSAByID[kid]
+			if !ok {
+				return nil, fmt.Errorf("unknown key id %q", kid)
+			}
+			return key, nil
+		}
+		if v.keys.RSA == nil {
+			return nil, errors.New("RS256 tokens without a key id are not accepted")
+		}
+		return v.keys.RSA, nil
+	default:
+		return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+	}
+}
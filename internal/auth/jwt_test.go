@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var testHMACKey = []byte("test-secret")
+
+func signHS256(t *testing.T, key []byte, c jwt.Claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return token
+}
+
+func validClaims(subject string) claims {
+	return claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    "blog-test",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Name:  "Test User",
+		Roles: []string{"author"},
+	}
+}
+
+func TestVerifier_HS256(t *testing.T) {
+	verifier, err := NewVerifier(KeySet{HMAC: testHMACKey}, "blog-test", "")
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+
+	expired := validClaims("user-1")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+
+	noExpiry := validClaims("user-1")
+	noExpiry.ExpiresAt = nil
+
+	wrongIssuer := validClaims("user-1")
+	wrongIssuer.Issuer = "someone-else"
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:    "valid token",
+			token:   signHS256(t, testHMACKey, validClaims("user-1")),
+			wantErr: false,
+		},
+		{
+			name:    "wrong key",
+			token:   signHS256(t, []byte("other-secret"), validClaims("user-1")),
+			wantErr: true,
+		},
+		{
+			name:    "expired token",
+			token:   signHS256(t, testHMACKey, expired),
+			wantErr: true,
+		},
+		{
+			name:    "missing expiry",
+			token:   signHS256(t, testHMACKey, noExpiry),
+			wantErr: true,
+		},
+		{
+			name:    "wrong issuer",
+			token:   signHS256(t, testHMACKey, wrongIssuer),
+			wantErr: true,
+		},
+		{
+			name:    "missing subject",
+			token:   signHS256(t, testHMACKey, validClaims("")),
+			wantErr: true,
+		},
+		{
+			name:    "malformed token",
+			token:   "not-a-jwt",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := verifier.Verify(tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Verify() expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() unexpected error: %v", err)
+			}
+			if id.Subject != "user-1" {
+				t.Errorf("Verify() subject = %v, want user-1", id.Subject)
+			}
+			if id.Name != "Test User" {
+				t.Errorf("Verify() name = %v, want Test User", id.Name)
+			}
+			if len(id.Roles) != 1 || id.Roles[0] != "author" {
+				t.Errorf("Verify() roles = %v, want [author]", id.Roles)
+			}
+		})
+	}
+}
+
+func TestVerifier_RS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	verifier, err := NewVerifier(KeySet{
+		RSA:     &key.PublicKey,
+		RSAByID: map[string]*rsa.PublicKey{"key-1": &key.PublicKey},
+	}, "", "")
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+
+	sign := func(kid string, k *rsa.PrivateKey) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, validClaims("user-2"))
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		s, err := token.SignedString(k)
+		if err != nil {
+			t.Fatalf("Failed to sign token: %v", err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "default key", token: sign("", key), wantErr: false},
+		{name: "known key id", token: sign("key-1", key), wantErr: false},
+		{name: "unknown key id", token: sign("key-2", key), wantErr: true},
+		{name: "wrong signing key", token: sign("key-1", other), wantErr: true},
+		{name: "HS256 not configured", token: signHS256(t, testHMACKey, validClaims("user-2")), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := verifier.Verify(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && id.Subject != "user-2" {
+				t.Errorf("Verify() subject = %v, want user-2", id.Subject)
+			}
+		})
+	}
+}
+
+func TestNewVerifier_NoKeys(t *testing.T) {
+	if _, err := NewVerifier(KeySet{}, "", ""); err == nil {
+		t.Error("NewVerifier() expected error without keys")
+	}
+}
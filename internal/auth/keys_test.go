@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoadHMACKey(t *testing.T) {
+	key, err := LoadHMACKey(writeFile(t, "secret", []byte("  s3cret\n")))
+	if err != nil {
+		t.Fatalf("LoadHMACKey() error = %v", err)
+	}
+	if string(key) != "s3cret" {
+		t.Errorf("LoadHMACKey() = %q, want %q", key, "s3cret")
+	}
+
+	if _, err := LoadHMACKey(writeFile(t, "empty", []byte("\n"))); err == nil {
+		t.Error("LoadHMACKey() expected error for empty file")
+	}
+}
+
+func TestLoadJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	set := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "key-1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "key-2",
+			},
+		},
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("Failed to marshal jwks: %v", err)
+	}
+
+	keys, err := LoadJWKS(writeFile(t, "jwks.json", data))
+	if err != nil {
+		t.Fatalf("LoadJWKS() error = %v", err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("LoadJWKS() returned %d keys, want 1", len(keys))
+	}
+	got, ok := keys["key-1"]
+	if !ok {
+		t.Fatal("LoadJWKS() missing key-1")
+	}
+	if !got.Equal(&key.PublicKey) {
+		t.Error("LoadJWKS() key does not match generated key")
+	}
+
+	if _, err := LoadJWKS(writeFile(t, "empty.json", []byte(`{"keys":[]}`))); err == nil {
+		t.Error("LoadJWKS() expected error for set without RSA keys")
+	}
+}
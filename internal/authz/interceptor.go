@@ -0,0 +1,43 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor enforces the policy's per-RPC permissions. It must
+// run after the authentication interceptor so the caller identity is known.
+func UnaryServerInterceptor(p *Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := p.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(p *Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := p.check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (p *Policy) check(ctx context.Context, fullMethod string) error {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "no authenticated caller")
+	}
+	if err := p.Authorize(id, fullMethod); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
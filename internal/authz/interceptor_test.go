@@ -0,0 +1,42 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor(DefaultPolicy())
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	tests := []struct {
+		name     string
+		id       *auth.Identity
+		method   string
+		wantCode codes.Code
+	}{
+		{name: "no identity", id: nil, method: "/blog.BlogService/ReadPost", wantCode: codes.Unauthenticated},
+		{name: "permitted", id: &auth.Identity{Subject: "u", Roles: []string{"author"}}, method: "/blog.BlogService/CreatePost", wantCode: codes.OK},
+		{name: "denied", id: &auth.Identity{Subject: "u", Roles: []string{"reader"}}, method: "/blog.BlogService/CreatePost", wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.id != nil {
+				ctx = auth.NewContext(ctx, tt.id)
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("interceptor code = %v, want %v (err=%v)", code, tt.wantCode, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package authz
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kpauljoseph/test/internal/auth"
+)
+
+// Role grants access to a set of RPCs. Methods are full gRPC method names
+// such as "/blog.BlogService/ReadPost"; a trailing "*" matches any method
+// with that prefix. ModifyAny lets the role change resources owned by other
+// users, which otherwise only their owner may do.
+type Role struct {
+	Methods   []string `json:"methods"`
+	ModifyAny bool     `json:"modify_any"`
+}
+
+// Policy maps role names to their permissions. Identities that carry no
+// roles are treated as having DefaultRoles.
+type Policy struct {
+	Roles        map[string]Role `json:"roles"`
+	DefaultRoles []string        `json:"default_roles"`
+}
+
+// DefaultPolicy returns the built-in rules: readers may read, comment and
+// follow authors and tags, authors may also create posts and series, edit
+// or delete their own and manage their profile, and editors and admins may
+// touch anything, moderate comments and manage tags and categories. Only
+// admins may manage API keys and webhooks.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		Roles: map[string]Role{
+			"reader": {
+				Methods: []string{
+					"/blog.BlogService/ReadPost",
+					"/blog.BlogService/ReactToPost",
+					"/blog.BlogService/RemoveReaction",
+					"/blog.BlogService/RecordView",
+					"/blog.BlogService/ListTrendingPosts",
+					"/blog.BlogService/GetRelatedPosts",
+					"/blog.TagService/ListTags",
+					"/blog.TagService/SuggestTags",
+					"/blog.CategoryService/ListCategories",
+					"/blog.CategoryService/ListCategoryPosts",
+					"/blog.AuthorService/GetAuthor",
+					"/blog.AuthorService/ListAuthors",
+					"/blog.FeedService/Follow",
+					"/blog.FeedService/Unfollow",
+					"/blog.FeedService/ListFollowing",
+					"/blog.FeedService/GetHomeFeed",
+					"/blog.CommentService/AddComment",
+					"/blog.CommentService/ListComments",
+					"/blog.CommentService/EditComment",
+					"/blog.CommentService/DeleteComment",
+				},
+			},
+			"author": {
+				Methods: []string{
+					"/blog.BlogService/ReadPost",
+					"/blog.BlogService/ReactToPost",
+					"/blog.BlogService/RemoveReaction",
+					"/blog.BlogService/RecordView",
+					"/blog.BlogService/ListTrendingPosts",
+					"/blog.BlogService/GetRelatedPosts",
+					"/blog.TagService/ListTags",
+					"/blog.TagService/SuggestTags",
+					"/blog.CategoryService/ListCategories",
+					"/blog.CategoryService/ListCategoryPosts",
+					"/blog.AuthorService/GetAuthor",
+					"/blog.AuthorService/ListAuthors",
+					"/blog.FeedService/Follow",
+					"/blog.FeedService/Unfollow",
+					"/blog.FeedService/ListFollowing",
+					"/blog.FeedService/GetHomeFeed",
+					"/blog.BlogService/CreatePost",
+					"/blog.BlogService/UpdatePost",
+					"/blog.BlogService/DeletePost",
+					"/blog.BlogService/GetPostStats",
+					"/blog.SeriesService/CreateSeries",
+					"/blog.SeriesService/AddPostToSeries",
+					"/blog.SeriesService/ReorderSeries",
+					"/blog.AuthorService/CreateAuthor",
+					"/blog.AuthorService/UpdateAuthor",
+					"/blog.CommentService/AddComment",
+					"/blog.CommentService/ListComments",
+					"/blog.CommentService/EditComment",
+					"/blog.CommentService/DeleteComment",
+				},
+			},
+			"editor": {
+				Methods:   []string{"/blog.BlogService/*", "/blog.CommentService/*", "/blog.TagService/*", "/blog.CategoryService/*", "/blog.SeriesService/*", "/blog.AuthorService/*", "/blog.FeedService/*"},
+				ModifyAny: true,
+			},
+			"admin": {
+				Methods:   []string{"*"},
+				ModifyAny: true,
+			},
+		},
+		DefaultRoles: []string{"reader"},
+	}
+}
+
+// LoadPolicy reads a JSON policy file.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read policy: %w", err)
+	}
+
+	var p Policy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parse policy: %w", err)
+	}
+	if len(p.Roles) == 0 {
+		return nil, fmt.Errorf("policy %s defines no roles", path)
+	}
+	for _, name := range p.DefaultRoles {
+		if _, ok := p.Roles[name]; !ok {
+			return nil, fmt.Errorf("default role %q is not defined", name)
+		}
+	}
+	return &p, nil
+}
+
+// Authorize reports whether id may call fullMethod. The returned error
+// explains why access was refused.
+func (p *Policy) Authorize(id *auth.Identity, fullMethod string) error {
+	roles := p.rolesOf(id)
+	for _, name := range roles {
+		role, ok := p.Roles[name]
+		if !ok {
+			continue
+		}
+		for _, pattern := range role.Methods {
+			if matchMethod(pattern, fullMethod) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("roles %v are not permitted to call %s", roles, fullMethod)
+}
+
+// CanModify reports whether id may change a resource owned by owner.
+func (p *Policy) CanModify(id *auth.Identity, owner string) bool {
+	if id.Subject == owner {
+		return true
+	}
+	for _, name := range p.rolesOf(id) {
+		if p.Roles[name].ModifyAny {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) rolesOf(id *auth.Identity) []string {
+	if len(id.Roles) == 0 {
+		return p.DefaultRoles
+	}
+	return id.Roles
+}
+
+func matchMethod(pattern, fullMethod string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(fullMethod, prefix)
+	}
+	return pattern == fullMethod
+}
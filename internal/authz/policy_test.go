@@ -0,0 +1,101 @@
+package authz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kpauljoseph/test/internal/auth"
+)
+
+func TestPolicy_Authorize(t *testing.T) {
+	policy := DefaultPolicy()
+
+	tests := []struct {
+		name    string
+		roles   []string
+		method  string
+		wantErr bool
+	}{
+		{name: "reader can read", roles: []string{"reader"}, method: "/blog.BlogService/ReadPost", wantErr: false},
+		{name: "reader cannot create", roles: []string{"reader"}, method: "/blog.BlogService/CreatePost", wantErr: true},
+		{name: "default role can read", roles: nil, method: "/blog.BlogService/ReadPost", wantErr: false},
+		{name: "default role cannot delete", roles: nil, method: "/blog.BlogService/DeletePost", wantErr: true},
+		{name: "author can create", roles: []string{"author"}, method: "/blog.BlogService/CreatePost", wantErr: false},
+		{name: "editor wildcard", roles: []string{"editor"}, method: "/blog.BlogService/DeletePost", wantErr: false},
+		{name: "reader can comment", roles: []string{"reader"}, method: "/blog.CommentService/AddComment", wantErr: false},
+		{name: "author cannot moderate", roles: []string{"author"}, method: "/blog.CommentService/ModerateComment", wantErr: true},
+		{name: "editor can moderate", roles: []string{"editor"}, method: "/blog.CommentService/ModerateComment", wantErr: false},
+		{name: "editor outside service", roles: []string{"editor"}, method: "/blog.AdminService/Anything", wantErr: true},
+		{name: "admin matches everything", roles: []string{"admin"}, method: "/blog.AdminService/Anything", wantErr: false},
+		{name: "editor cannot manage webhooks", roles: []string{"editor"}, method: "/blog.WebhookService/CreateWebhook", wantErr: true},
+		{name: "reader cannot list webhooks", roles: nil, method: "/blog.WebhookService/ListWebhooks", wantErr: true},
+		{name: "admin can manage webhooks", roles: []string{"admin"}, method: "/blog.WebhookService/CreateWebhook", wantErr: false},
+		{name: "unknown role", roles: []string{"intern"}, method: "/blog.BlogService/ReadPost", wantErr: true},
+		{name: "any matching role", roles: []string{"intern", "reader"}, method: "/blog.BlogService/ReadPost", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Authorize(&auth.Identity{Subject: "user-1", Roles: tt.roles}, tt.method)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Authorize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicy_CanModify(t *testing.T) {
+	policy := DefaultPolicy()
+
+	tests := []struct {
+		name  string
+		id    *auth.Identity
+		owner string
+		want  bool
+	}{
+		{name: "author owns post", id: &auth.Identity{Subject: "alice", Roles: []string{"author"}}, owner: "alice", want: true},
+		{name: "author on other post", id: &auth.Identity{Subject: "bob", Roles: []string{"author"}}, owner: "alice", want: false},
+		{name: "editor on other post", id: &auth.Identity{Subject: "carol", Roles: []string{"editor"}}, owner: "alice", want: true},
+		{name: "admin on other post", id: &auth.Identity{Subject: "dave", Roles: []string{"admin"}}, owner: "alice", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.CanModify(tt.id, tt.owner); got != tt.want {
+				t.Errorf("CanModify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	if err := os.WriteFile(valid, []byte(`{
+		"roles": {"viewer": {"methods": ["/blog.BlogService/ReadPost"]}},
+		"default_roles": ["viewer"]
+	}`), 0o600); err != nil {
+		t.Fatalf("Failed to write policy: %v", err)
+	}
+
+	policy, err := LoadPolicy(valid)
+	if err != nil {
+		t.Fatalf("LoadPolicy() error = %v", err)
+	}
+	if err := policy.Authorize(&auth.Identity{Subject: "u"}, "/blog.BlogService/ReadPost"); err != nil {
+		t.Errorf("Authorize() error = %v", err)
+	}
+
+	undefinedDefault := filepath.Join(dir, "undefined.json")
+	if err := os.WriteFile(undefinedDefault, []byte(`{
+		"roles": {"viewer": {"methods": ["*"]}},
+		"default_roles": ["reader"]
+	}`), 0o600); err != nil {
+		t.Fatalf("Failed to write policy: %v", err)
+	}
+	if _, err := LoadPolicy(undefinedDefault); err == nil {
+		t.Error("LoadPolicy() expected error for undefined default role")
+	}
+}
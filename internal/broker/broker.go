@@ -0,0 +1,154 @@
+package broker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Message is a published message.
+type Message struct {
+	Subject string
+	Reply   string
+	Data    []byte
+}
+
+// Subscription receives the messages published on subjects matching its
+// pattern.
+type Subscription struct {
+	broker  *Broker
+	id      uint64
+	pattern string
+	queue   string
+	handler func(Message)
+}
+
+// Broker routes messages by subject with NATS semantics. Subjects are
+// tokens separated by dots. In subscription patterns "*" matches any one
+// token and a final ">" matches one or more remaining tokens. Of the
+// subscriptions sharing a queue group, each message goes to only one.
+type Broker struct {
+	mu     sync.RWMutex
+	nextID uint64
+	subs   map[uint64]*Subscription
+	// queueTurn rotates messages through the members of each queue group.
+	queueTurn map[string]uint64
+}
+
+func New() *Broker {
+	return &Broker{
+		subs:      make(map[uint64]*Subscription),
+		queueTurn: make(map[string]uint64),
+	}
+}
+
+// Subscribe calls handler for every message published on a subject
+// matching pattern. An empty queue subscribes outside any queue group.
+// Handlers run on the publishing goroutine and must not block.
+func (b *Broker) Subscribe(pattern, queue string, handler func(Message)) (*Subscription, error) {
+	if !validSubject(pattern, true) {
+		return nil, fmt.Errorf("invalid subject %q", pattern)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.nextID++
+	sub := &Subscription{
+		broker:  b,
+		id:      b.nextID,
+		pattern: pattern,
+		queue:   queue,
+		handler: handler,
+	}
+	b.subs[sub.id] = sub
+	return sub, nil
+}
+
+// Unsubscribe stops the subscription. It is safe to call more than once.
+func (sub *Subscription) Unsubscribe() {
+	sub.broker.mu.Lock()
+	defer sub.broker.mu.Unlock()
+	delete(sub.broker.subs, sub.id)
+}
+
+// Publish delivers data to every matching subscription and to one member
+// of every matching queue group.
+func (b *Broker) Publish(subject, reply string, data []byte) error {
+	if !validSubject(subject, false) {
+		return fmt.Errorf("invalid subject %q", subject)
+	}
+	if reply != "" && !validSubject(reply, false) {
+		return fmt.Errorf("invalid reply subject %q", reply)
+	}
+
+	var targets []*Subscription
+	groups := make(map[string][]*Subscription)
+	b.mu.RLock()
+	for _, sub := range b.subs {
+		if !matchSubject(sub.pattern, subject) {
+			continue
+		}
+		if sub.queue == "" {
+			targets = append(targets, sub)
+		} else {
+			groups[sub.queue] = append(groups[sub.queue], sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	if len(groups) > 0 {
+		b.mu.Lock()
+		for queue, members := range groups {
+			// Subscriptions are collected in map order, so sort them to
+			// rotate through the group fairly.
+			slices.SortFunc(members, func(x, y *Subscription) int { return cmp.Compare(x.id, y.id) })
+			turn := b.queueTurn[queue]
+			b.queueTurn[queue] = turn + 1
+			targets = append(targets, members[turn%uint64(len(members))])
+		}
+		b.mu.Unlock()
+	}
+
+	msg := Message{Subject: subject, Reply: reply, Data: data}
+	for _, sub := range targets {
+		sub.handler(msg)
+	}
+	return nil
+}
+
+// validSubject reports whether subject is well formed. Wildcards are only
+// allowed in subscription patterns.
+func validSubject(subject string, wildcards bool) bool {
+	if subject == "" {
+		return false
+	}
+	tokens := strings.Split(subject, ".")
+	for i, token := range tokens {
+		if token == "" || strings.ContainsAny(token, " \t\r\n") {
+			return false
+		}
+		if token == "*" || token == ">" {
+			if !wildcards || (token == ">" && i != len(tokens)-1) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchSubject reports whether subject matches pattern.
+func matchSubject(pattern, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+		if i >= len(subjectTokens) || (token != "*" && token != subjectTokens[i]) {
+			return false
+		}
+	}
+	return len(patternTokens) == len(subjectTokens)
+}
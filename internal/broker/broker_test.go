@@ -0,0 +1,103 @@
+package broker
+
+import (
+	"testing"
+)
+
+func TestMatchSubject(t *testing.T) {
+	tests := []struct {
+		pattern string
+		subject string
+		want    bool
+	}{
+		{pattern: "blog.post.created", subject: "blog.post.created", want: true},
+		{pattern: "blog.post.created", subject: "blog.post.deleted", want: false},
+		{pattern: "blog.*.created", subject: "blog.post.created", want: true},
+		{pattern: "blog.*", subject: "blog.post.created", want: false},
+		{pattern: "blog.>", subject: "blog.post.created", want: true},
+		{pattern: "blog.>", subject: "blog", want: false},
+		{pattern: "blog.post.created.>", subject: "blog.post.created", want: false},
+		{pattern: ">", subject: "blog", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.subject, func(t *testing.T) {
+			if got := matchSubject(tt.pattern, tt.subject); got != tt.want {
+				t.Errorf("matchSubject(%q, %q) = %v, want %v", tt.pattern, tt.subject, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidSubject(t *testing.T) {
+	tests := []struct {
+		subject   string
+		wildcards bool
+		want      bool
+	}{
+		{subject: "blog.post.created", want: true},
+		{subject: "", want: false},
+		{subject: "blog..created", want: false},
+		{subject: "blog.post created", want: false},
+		{subject: "blog.*", want: false},
+		{subject: "blog.*", wildcards: true, want: true},
+		{subject: "blog.>", wildcards: true, want: true},
+		{subject: "blog.>.created", wildcards: true, want: false},
+	}
+	for _, tt := range tests {
+		if got := validSubject(tt.subject, tt.wildcards); got != tt.want {
+			t.Errorf("validSubject(%q, %v) = %v, want %v", tt.subject, tt.wildcards, got, tt.want)
+		}
+	}
+}
+
+func TestBroker_Publish(t *testing.T) {
+	b := New()
+	var exact, wildcard []string
+	sub, err := b.Subscribe("blog.post.created", "", func(m Message) { exact = append(exact, string(m.Data)) })
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	b.Subscribe("blog.>", "", func(m Message) { wildcard = append(wildcard, m.Subject) })
+
+	if _, err := b.Subscribe("blog..post", "", func(Message) {}); err == nil {
+		t.Error("Subscribe() with invalid pattern expected error")
+	}
+	if err := b.Publish("blog.*", "", nil); err == nil {
+		t.Error("Publish() to a wildcard subject expected error")
+	}
+
+	b.Publish("blog.post.created", "", []byte("first"))
+	b.Publish("blog.post.deleted", "", []byte("second"))
+	sub.Unsubscribe()
+	sub.Unsubscribe()
+	b.Publish("blog.post.created", "", []byte("third"))
+
+	if len(exact) != 1 || exact[0] != "first" {
+		t.Errorf("exact subscription got %v, want [first]", exact)
+	}
+	if len(wildcard) != 3 {
+		t.Errorf("wildcard subscription got %v, want 3 messages", wildcard)
+	}
+}
+
+func TestBroker_QueueGroups(t *testing.T) {
+	b := New()
+	counts := make([]int, 3)
+	for i := range counts {
+		b.Subscribe("jobs", "workers", func(Message) { counts[i]++ })
+	}
+	var all int
+	b.Subscribe("jobs", "", func(Message) { all++ })
+
+	for i := 0; i < 9; i++ {
+		b.Publish("jobs", "", nil)
+	}
+	for i, n := range counts {
+		if n != 3 {
+			t.Errorf("queue member %d got %d messages, want 3", i, n)
+		}
+	}
+	if all != 9 {
+		t.Errorf("plain subscription got %d messages, want 9", all)
+	}
+}
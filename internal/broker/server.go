@@ -0,0 +1,481 @@
+package broker
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxPayload is the largest message a client may publish.
+	MaxPayload = 1 << 20
+	// maxControlLine bounds the length of a protocol line.
+	maxControlLine = 4096
+	// outboundBuffer is how many messages may wait for a client before it
+	// is disconnected as a slow consumer.
+	outboundBuffer = 1024
+	// closeTimeout bounds the final flush to a disconnecting client.
+	closeTimeout = time.Second
+)
+
+// serverInfo is sent to clients on connect. Clients use headers and
+// max_payload to decide what they may send, and auth_required to decide
+// whether to send credentials.
+type serverInfo struct {
+	ServerID     string `json:"server_id"`
+	ServerName   string `json:"server_name"`
+	Version      string `json:"version"`
+	Proto        int    `json:"proto"`
+	Headers      bool   `json:"headers"`
+	MaxPayload   int    `json:"max_payload"`
+	AuthRequired bool   `json:"auth_required,omitempty"`
+}
+
+// Server exposes a Broker over the NATS client protocol, so that standard
+// NATS clients can publish and subscribe. It supports the core protocol
+// with optional token authentication, but not TLS, clustering or message
+// headers.
+type Server struct {
+	broker *Broker
+	info   []byte
+	// token is the auth_token clients must send in CONNECT, if any.
+	token string
+	// readOnly are the subject patterns clients may subscribe to but not
+	// publish on.
+	readOnly []string
+
+	mu       sync.Mutex
+	closed   bool
+	listener net.Listener
+	conns    map[*conn]struct{}
+}
+
+// ServerOption configures optional Server behaviour.
+type ServerOption func(*Server)
+
+// WithAuthToken makes clients authenticate with token, sent as auth_token
+// in CONNECT, before anything else.
+func WithAuthToken(token string) ServerOption {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithReadOnlySubjects refuses client publishes on subjects matching any
+// of patterns, so that clients cannot spoof messages that only the server
+// publishes.
+func WithReadOnlySubjects(patterns ...string) ServerOption {
+	return func(s *Server) {
+		s.readOnly = append(s.readOnly, patterns...)
+	}
+}
+
+func NewServer(broker *Broker, opts ...ServerOption) *Server {
+	s := &Server{
+		broker: broker,
+		conns:  make(map[*conn]struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	info, _ := json.Marshal(serverInfo{
+		ServerID:     uuid.New().String(),
+		ServerName:   "blog",
+		Version:      "2.10.0",
+		Proto:        1,
+		MaxPayload:   MaxPayload,
+		AuthRequired: s.token != "",
+	})
+	s.info = []byte("INFO " + string(info) + "\r\n")
+	return s
+}
+
+// Serve accepts client connections on l until Close is called.
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return net.ErrClosed
+	}
+	s.listener = l
+	s.mu.Unlock()
+
+	for {
+		nc, err := l.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return nil
+			}
+			return err
+		}
+		c := newConn(s, nc)
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			nc.Close()
+			return nil
+		}
+		s.conns[c] = struct{}{}
+		s.mu.Unlock()
+		go c.serve()
+	}
+}
+
+// Close stops accepting clients and disconnects the connected ones.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	conns := make([]*conn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	listener := s.listener
+	s.mu.Unlock()
+
+	for _, c := range conns {
+		c.close()
+	}
+	if listener != nil {
+		return listener.Close()
+	}
+	return nil
+}
+
+func (s *Server) remove(c *conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.conns, c)
+}
+
+// clientSub is a subscription made by a client under its subscription ID.
+// max is the number of messages after which it ends, or 0 for no limit.
+type clientSub struct {
+	sub       *Subscription
+	sid       string
+	max       int
+	delivered int
+}
+
+type conn struct {
+	server *Server
+	nc     net.Conn
+	out    chan []byte
+	done   chan struct{}
+	once   sync.Once
+
+	mu            sync.Mutex
+	verbose       bool
+	authenticated bool
+	subs          map[string]*clientSub
+}
+
+func newConn(server *Server, nc net.Conn) *conn {
+	return &conn{
+		server: server,
+		nc:     nc,
+		out:    make(chan []byte, outboundBuffer),
+		done:   make(chan struct{}),
+		subs:   make(map[string]*clientSub),
+	}
+}
+
+func (c *conn) serve() {
+	defer c.close()
+	go c.writeLoop()
+	c.send(c.server.info)
+
+	r := bufio.NewReaderSize(c.nc, maxControlLine)
+	for {
+		line, err := r.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			c.fail("Maximum Control Line Exceeded")
+			return
+		}
+		if err != nil {
+			return
+		}
+		err = c.handle(r, string(bytes.TrimRight(line, "\r\n")))
+		var denied *permissionError
+		if errors.As(err, &denied) {
+			// Like a NATS server, refuse the message but keep the client.
+			slog.Warn("Refused broker publish", "remote_addr", c.nc.RemoteAddr().String(), "subject", denied.subject)
+			c.send([]byte("-ERR '" + err.Error() + "'\r\n"))
+			continue
+		}
+		if err != nil {
+			c.fail(err.Error())
+			return
+		}
+	}
+}
+
+// handle processes one protocol line, reading the payload that follows PUB.
+func (c *conn) handle(r *bufio.Reader, line string) error {
+	op, args, _ := strings.Cut(line, " ")
+	op = strings.ToUpper(op)
+	fields := strings.Fields(args)
+
+	c.mu.Lock()
+	authenticated := c.authenticated
+	c.mu.Unlock()
+	if c.server.token != "" && !authenticated && op != "CONNECT" {
+		return fmt.Errorf("Authorization Violation")
+	}
+
+	switch op {
+	case "CONNECT":
+		var opts struct {
+			Verbose   bool   `json:"verbose"`
+			AuthToken string `json:"auth_token"`
+		}
+		if err := json.Unmarshal([]byte(args), &opts); err != nil {
+			return fmt.Errorf("Invalid CONNECT Options")
+		}
+		if c.server.token != "" && subtle.ConstantTimeCompare([]byte(opts.AuthToken), []byte(c.server.token)) != 1 {
+			return fmt.Errorf("Authorization Violation")
+		}
+		c.mu.Lock()
+		c.verbose = opts.Verbose
+		c.authenticated = true
+		c.mu.Unlock()
+	case "PING":
+		c.send([]byte("PONG\r\n"))
+		return nil
+	case "PONG":
+		return nil
+	case "SUB":
+		if err := c.subscribe(fields); err != nil {
+			return err
+		}
+	case "UNSUB":
+		if err := c.unsubscribe(fields); err != nil {
+			return err
+		}
+	case "PUB":
+		if err := c.publish(r, fields); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("Unknown Protocol Operation")
+	}
+	c.ok()
+	return nil
+}
+
+// subscribe handles "SUB <subject> [queue group] <sid>".
+func (c *conn) subscribe(fields []string) error {
+	if len(fields) != 2 && len(fields) != 3 {
+		return fmt.Errorf("Invalid Subscription")
+	}
+	pattern, sid := fields[0], fields[len(fields)-1]
+	var queue string
+	if len(fields) == 3 {
+		queue = fields[1]
+	}
+
+	cs := &clientSub{sid: sid}
+	sub, err := c.server.broker.Subscribe(pattern, queue, func(m Message) { c.deliver(cs, m) })
+	if err != nil {
+		return fmt.Errorf("Invalid Subject")
+	}
+	cs.sub = sub
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if old, ok := c.subs[sid]; ok {
+		old.sub.Unsubscribe()
+	}
+	c.subs[sid] = cs
+	return nil
+}
+
+// unsubscribe handles "UNSUB <sid> [max messages]".
+func (c *conn) unsubscribe(fields []string) error {
+	if len(fields) != 1 && len(fields) != 2 {
+		return fmt.Errorf("Invalid Unsubscribe")
+	}
+	max := 0
+	if len(fields) == 2 {
+		n, err := strconv.Atoi(fields[1])
+		if err != nil || n < 0 {
+			return fmt.Errorf("Invalid Unsubscribe")
+		}
+		max = n
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cs, ok := c.subs[fields[0]]
+	if !ok {
+		return nil
+	}
+	if max > 0 && cs.delivered < max {
+		cs.max = max
+		return nil
+	}
+	cs.sub.Unsubscribe()
+	delete(c.subs, cs.sid)
+	return nil
+}
+
+// publish handles "PUB <subject> [reply-to] <#bytes>" and its payload.
+func (c *conn) publish(r *bufio.Reader, fields []string) error {
+	if len(fields) != 2 && len(fields) != 3 {
+		return fmt.Errorf("Invalid Publish")
+	}
+	size, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil || size < 0 {
+		return fmt.Errorf("Invalid Publish")
+	}
+	if size > MaxPayload {
+		return fmt.Errorf("Maximum Payload Violation")
+	}
+	var reply string
+	if len(fields) == 3 {
+		reply = fields[1]
+	}
+
+	payload := make([]byte, size+2)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return err
+	}
+	if !bytes.HasSuffix(payload, []byte("\r\n")) {
+		return fmt.Errorf("Invalid Publish")
+	}
+	for _, pattern := range c.server.readOnly {
+		if matchSubject(pattern, fields[0]) {
+			return &permissionError{subject: fields[0]}
+		}
+	}
+	if err := c.server.broker.Publish(fields[0], reply, payload[:size]); err != nil {
+		return fmt.Errorf("Invalid Subject")
+	}
+	return nil
+}
+
+// permissionError refuses a publish on a read-only subject.
+type permissionError struct {
+	subject string
+}
+
+func (e *permissionError) Error() string {
+	return "Permissions Violation for Publish to " + e.subject
+}
+
+// deliver sends m to the client as a MSG. It runs on the publishing
+// goroutine, so it only queues the message.
+func (c *conn) deliver(cs *clientSub, m Message) {
+	c.mu.Lock()
+	if c.subs[cs.sid] != cs {
+		c.mu.Unlock()
+		return
+	}
+	cs.delivered++
+	if cs.max > 0 && cs.delivered >= cs.max {
+		cs.sub.Unsubscribe()
+		delete(c.subs, cs.sid)
+	}
+	c.mu.Unlock()
+
+	var b bytes.Buffer
+	b.WriteString("MSG ")
+	b.WriteString(m.Subject)
+	b.WriteString(" ")
+	b.WriteString(cs.sid)
+	if m.Reply != "" {
+		b.WriteString(" ")
+		b.WriteString(m.Reply)
+	}
+	fmt.Fprintf(&b, " %d\r\n", len(m.Data))
+	b.Write(m.Data)
+	b.WriteString("\r\n")
+	c.send(b.Bytes())
+}
+
+func (c *conn) ok() {
+	c.mu.Lock()
+	verbose := c.verbose
+	c.mu.Unlock()
+	if verbose {
+		c.send([]byte("+OK\r\n"))
+	}
+}
+
+// fail reports a protocol error to the client, which is sent before the
+// connection is closed.
+func (c *conn) fail(msg string) {
+	slog.Warn("Closing broker client", "remote_addr", c.nc.RemoteAddr().String(), "error", msg)
+	c.send([]byte("-ERR '" + msg + "'\r\n"))
+}
+
+// send queues data for the client, disconnecting it if it has fallen too
+// far behind.
+func (c *conn) send(data []byte) {
+	select {
+	case c.out <- data:
+	case <-c.done:
+	default:
+		slog.Warn("Disconnecting slow broker client", "remote_addr", c.nc.RemoteAddr().String())
+		c.close()
+	}
+}
+
+// writeLoop writes queued data to the client. Once the connection is
+// closed it flushes what is left, such as a final error, and closes the
+// socket.
+func (c *conn) writeLoop() {
+	defer c.nc.Close()
+	w := bufio.NewWriter(c.nc)
+	for {
+		select {
+		case <-c.done:
+			for len(c.out) > 0 {
+				w.Write(<-c.out)
+			}
+			w.Flush()
+			return
+		case data := <-c.out:
+			w.Write(data)
+			// Batch whatever else is already queued into one write.
+			for len(c.out) > 0 {
+				w.Write(<-c.out)
+			}
+			if err := w.Flush(); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+func (c *conn) close() {
+	c.once.Do(func() {
+		close(c.done)
+		// Bound the final flush so that a client that stopped reading
+		// cannot hold the connection open.
+		c.nc.SetWriteDeadline(time.Now().Add(closeTimeout))
+		c.nc.SetReadDeadline(time.Now())
+		c.mu.Lock()
+		for sid, cs := range c.subs {
+			cs.sub.Unsubscribe()
+			delete(c.subs, sid)
+		}
+		c.mu.Unlock()
+		c.server.remove(c)
+	})
+}
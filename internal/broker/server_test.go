@@ -0,0 +1,177 @@
+package broker
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testClient struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func dial(t *testing.T, addr string) *testClient {
+	t.Helper()
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	c := &testClient{t: t, conn: conn, r: bufio.NewReader(conn)}
+	if line := c.line(); !strings.HasPrefix(line, "INFO {") {
+		t.Fatalf("first line = %q, want INFO", line)
+	}
+	return c
+}
+
+func (c *testClient) send(s string) {
+	c.t.Helper()
+	if _, err := io.WriteString(c.conn, s); err != nil {
+		c.t.Fatalf("write error = %v", err)
+	}
+}
+
+func (c *testClient) line() string {
+	c.t.Helper()
+	c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		c.t.Fatalf("read error = %v", err)
+	}
+	return strings.TrimRight(line, "\r\n")
+}
+
+func (c *testClient) expect(want string) {
+	c.t.Helper()
+	if got := c.line(); got != want {
+		c.t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func startServer(t *testing.T, opts ...ServerOption) (*Broker, string) {
+	b := New()
+	srv := NewServer(b, opts...)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	go srv.Serve(l)
+	t.Cleanup(func() { srv.Close() })
+	return b, l.Addr().String()
+}
+
+func TestServer(t *testing.T) {
+	b, addr := startServer(t)
+	sub := dial(t, addr)
+	pub := dial(t, addr)
+
+	sub.send("CONNECT {\"verbose\":true}\r\n")
+	sub.expect("+OK")
+	sub.send("PING\r\n")
+	sub.expect("PONG")
+	sub.send("SUB blog.> 1\r\n")
+	sub.expect("+OK")
+	sub.send("SUB once 2\r\nUNSUB 2 1\r\n")
+	sub.expect("+OK")
+	sub.expect("+OK")
+
+	// Messages from the embedding process and from other clients arrive
+	// alike.
+	b.Publish("blog.post.created", "", []byte(`{"id":"1"}`))
+	sub.expect("MSG blog.post.created 1 10")
+	sub.expect(`{"id":"1"}`)
+
+	pub.send("CONNECT {}\r\nPUB blog.note reply.to 5\r\nhello\r\nPUB once 1\r\na\r\nPUB once 1\r\nb\r\nPUB blog.done 0\r\n\r\n")
+	sub.expect("MSG blog.note 1 reply.to 5")
+	sub.expect("hello")
+	sub.expect("MSG once 2 1")
+	sub.expect("a")
+	sub.expect("MSG blog.done 1 0")
+	sub.expect("")
+
+	sub.send("UNSUB 1\r\n")
+	sub.expect("+OK")
+	pub.send("PUB blog.note 3\r\nbye\r\nPING\r\n")
+	pub.expect("PONG")
+	sub.send("PING\r\n")
+	sub.expect("PONG")
+}
+
+func TestServer_ProtocolErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "unknown operation", input: "HELLO\r\n", want: "-ERR 'Unknown Protocol Operation'"},
+		{name: "invalid subject", input: "SUB blog..post 1\r\n", want: "-ERR 'Invalid Subject'"},
+		{name: "payload too large", input: "PUB blog 2000000\r\n", want: "-ERR 'Maximum Payload Violation'"},
+		{name: "payload size mismatch", input: "PUB blog 2\r\nabc\r\n", want: "-ERR 'Invalid Publish'"},
+	}
+
+	_, addr := startServer(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := dial(t, addr)
+			c.send(tt.input)
+			c.expect(tt.want)
+			c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+			if _, err := c.r.ReadString('\n'); err == nil {
+				t.Error("connection still open after protocol error")
+			}
+		})
+	}
+}
+
+func TestServer_AuthToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "no connect", input: "SUB blog.> 1\r\n", want: "-ERR 'Authorization Violation'"},
+		{name: "missing token", input: "CONNECT {}\r\n", want: "-ERR 'Authorization Violation'"},
+		{name: "wrong token", input: "CONNECT {\"auth_token\":\"guess\"}\r\n", want: "-ERR 'Authorization Violation'"},
+	}
+
+	_, addr := startServer(t, WithAuthToken("secret"))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := dial(t, addr)
+			c.send(tt.input)
+			c.expect(tt.want)
+			c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+			if _, err := c.r.ReadString('\n'); err == nil {
+				t.Error("connection still open after failed authentication")
+			}
+		})
+	}
+
+	c := dial(t, addr)
+	c.send("CONNECT {\"verbose\":true,\"auth_token\":\"secret\"}\r\nPING\r\n")
+	c.expect("+OK")
+	c.expect("PONG")
+}
+
+func TestServer_ReadOnlySubjects(t *testing.T) {
+	b, addr := startServer(t, WithReadOnlySubjects("blog.>"))
+	c := dial(t, addr)
+	c.send("CONNECT {}\r\nSUB > 1\r\n")
+
+	// A refused publish is reported without dropping the client, and its
+	// payload is not mistaken for the next operation.
+	c.send("PUB blog.post.created 4\r\nPING\r\nPUB notes 2\r\nhi\r\n")
+	c.expect("-ERR 'Permissions Violation for Publish to blog.post.created'")
+	c.expect("MSG notes 1 2")
+	c.expect("hi")
+
+	// The embedding process still publishes on read-only subjects.
+	b.Publish("blog.post.created", "", []byte("{}"))
+	c.expect("MSG blog.post.created 1 2")
+	c.expect("{}")
+}
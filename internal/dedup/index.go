@@ -0,0 +1,197 @@
+package dedup
+
+import "sort"
+
+// Match is a stored post similar to some content.
+type Match struct {
+	PostID     string
+	Similarity float64
+}
+
+// Pair is two stored posts that are similar to each other.
+type Pair struct {
+	PostID      string
+	DuplicateID string
+	Similarity  float64
+}
+
+const (
+	// numBands and bandRows split a signature into bands for locality
+	// sensitive hashing. Posts that agree on every row of some band are
+	// candidates for comparison; posts with similarity s become candidates
+	// with probability 1-(1-s^bandRows)^numBands.
+	numBands = 32
+	bandRows = numHashes / numBands
+
+	// minBandedThreshold is the lowest threshold at which candidates are
+	// found through the bands. At 0.6 a matching post is missed about one
+	// time in a hundred, and less often the more similar it is; lower
+	// thresholds compare every post.
+	minBandedThreshold = 0.6
+)
+
+// Index holds the signatures of stored posts, together with their bands
+// so that near duplicates can be found without comparing every post. It is
+// not safe for concurrent use; callers provide their own locking.
+type Index struct {
+	signatures map[string]Signature
+	bands      [numBands]map[uint64]map[string]struct{}
+}
+
+// NewIndex creates an empty Index.
+func NewIndex() *Index {
+	x := &Index{signatures: make(map[string]Signature)}
+	for i := range x.bands {
+		x.bands[i] = make(map[uint64]map[string]struct{})
+	}
+	return x
+}
+
+// Clone returns a copy of x, so that a caller can search the copy after
+// releasing its lock.
+func (x *Index) Clone() *Index {
+	c := NewIndex()
+	for id, sig := range x.signatures {
+		c.Put(id, sig)
+	}
+	return c
+}
+
+// Put stores or replaces the signature of a post.
+func (x *Index) Put(postID string, sig Signature) {
+	x.Remove(postID)
+	x.signatures[postID] = sig
+	if sig.empty() {
+		// Empty texts are similar to nothing; banding them would make
+		// them all candidates of each other.
+		return
+	}
+	for i, key := range bandKeys(sig) {
+		bucket, ok := x.bands[i][key]
+		if !ok {
+			bucket = make(map[string]struct{})
+			x.bands[i][key] = bucket
+		}
+		bucket[postID] = struct{}{}
+	}
+}
+
+// Remove drops a post's signature.
+func (x *Index) Remove(postID string) {
+	sig, ok := x.signatures[postID]
+	if !ok {
+		return
+	}
+	delete(x.signatures, postID)
+	if sig.empty() {
+		return
+	}
+	for i, key := range bandKeys(sig) {
+		delete(x.bands[i][key], postID)
+		if len(x.bands[i][key]) == 0 {
+			delete(x.bands[i], key)
+		}
+	}
+}
+
+// Similar returns the posts whose similarity to sig is at least threshold,
+// most similar first, leaving out exclude.
+func (x *Index) Similar(sig Signature, threshold float64, exclude string) []Match {
+	candidates := x.signatures
+	if threshold >= minBandedThreshold && !sig.empty() {
+		candidates = make(map[string]Signature)
+		for i, key := range bandKeys(sig) {
+			for id := range x.bands[i][key] {
+				candidates[id] = x.signatures[id]
+			}
+		}
+	}
+
+	var matches []Match
+	for id, other := range candidates {
+		if id == exclude {
+			continue
+		}
+		if sim := Similarity(sig, other); sim >= threshold {
+			matches = append(matches, Match{PostID: id, Similarity: sim})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Similarity == matches[j].Similarity {
+			return matches[i].PostID < matches[j].PostID
+		}
+		return matches[i].Similarity > matches[j].Similarity
+	})
+	return matches
+}
+
+// Pairs returns every pair of posts whose similarity is at least
+// threshold, most similar first. Each pair is reported once.
+func (x *Index) Pairs(threshold float64) []Pair {
+	var pairs []Pair
+	compare := func(a, b string) {
+		if sim := Similarity(x.signatures[a], x.signatures[b]); sim >= threshold {
+			pairs = append(pairs, Pair{PostID: a, DuplicateID: b, Similarity: sim})
+		}
+	}
+
+	if threshold >= minBandedThreshold {
+		seen := make(map[[2]string]struct{})
+		for _, band := range x.bands {
+			for _, bucket := range band {
+				if len(bucket) < 2 {
+					continue
+				}
+				ids := sortedIDs(bucket)
+				for i, a := range ids {
+					for _, b := range ids[i+1:] {
+						if _, done := seen[[2]string{a, b}]; !done {
+							seen[[2]string{a, b}] = struct{}{}
+							compare(a, b)
+						}
+					}
+				}
+			}
+		}
+	} else {
+		ids := sortedIDs(x.signatures)
+		for i, a := range ids {
+			for _, b := range ids[i+1:] {
+				compare(a, b)
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Similarity != pairs[j].Similarity {
+			return pairs[i].Similarity > pairs[j].Similarity
+		}
+		if pairs[i].PostID != pairs[j].PostID {
+			return pairs[i].PostID < pairs[j].PostID
+		}
+		return pairs[i].DuplicateID < pairs[j].DuplicateID
+	})
+	return pairs
+}
+
+// bandKeys hashes each band of sig.
+func bandKeys(sig Signature) [numBands]uint64 {
+	var keys [numBands]uint64
+	for i := range keys {
+		h := uint64(i)
+		for _, v := range sig[i*bandRows : (i+1)*bandRows] {
+			h = mix(h ^ v)
+		}
+		keys[i] = h
+	}
+	return keys
+}
+
+func sortedIDs[V any](m map[string]V) []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,101 @@
+package dedup
+
+import (
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// numHashes is the signature length. The similarity estimate has a
+	// standard error of about 1/sqrt(numHashes).
+	numHashes = 128
+
+	// shingleSize is the number of consecutive words compared as a unit.
+	shingleSize = 3
+)
+
+// Signature is a MinHash fingerprint of a text. The fraction of positions
+// at which two signatures agree estimates the Jaccard similarity of the
+// texts' word shingles.
+type Signature [numHashes]uint64
+
+// Fingerprint computes the signature of text. Case, punctuation and
+// whitespace are ignored.
+func Fingerprint(text string) Signature {
+	var sig Signature
+	for i := range sig {
+		sig[i] = ^uint64(0)
+	}
+
+	for _, shingle := range shingles(text) {
+		h := fnv.New64a()
+		h.Write([]byte(shingle))
+		base := h.Sum64()
+		for i := range sig {
+			if v := mix(base ^ seeds[i]); v < sig[i] {
+				sig[i] = v
+			}
+		}
+	}
+	return sig
+}
+
+// Similarity returns the estimated Jaccard similarity of the texts behind
+// two signatures, between 0 and 1.
+func Similarity(a, b Signature) float64 {
+	if a.empty() || b.empty() {
+		return 0
+	}
+	same := 0
+	for i := range a {
+		if a[i] == b[i] {
+			same++
+		}
+	}
+	return float64(same) / numHashes
+}
+
+func (s Signature) empty() bool {
+	return s[0] == ^uint64(0)
+}
+
+// shingles returns the overlapping word sequences of text. Texts shorter
+// than a shingle are treated as a single shingle.
+func shingles(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) == 0 {
+		return nil
+	}
+	if len(words) < shingleSize {
+		return []string{strings.Join(words, " ")}
+	}
+	out := make([]string, 0, len(words)-shingleSize+1)
+	for i := 0; i+shingleSize <= len(words); i++ {
+		out = append(out, strings.Join(words[i:i+shingleSize], " "))
+	}
+	return out
+}
+
+// seeds derive the independent hash functions from one base hash.
+var seeds = func() [numHashes]uint64 {
+	var s [numHashes]uint64
+	x := uint64(0x9e3779b97f4a7c15)
+	for i := range s {
+		x = mix(x + uint64(i))
+		s[i] = x
+	}
+	return s
+}()
+
+// mix is the splitmix64 finaliser.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
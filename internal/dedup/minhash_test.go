@@ -0,0 +1,91 @@
+package dedup
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const article = `Go's garbage collector is a concurrent, tri-color, mark and sweep
+collector. It runs alongside the program and aims to keep pause times short.
+The GOGC setting controls how much the heap may grow between collections,
+and the memory limit caps the total heap size when memory is tight.`
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		wantMin float64
+		wantMax float64
+	}{
+		{name: "identical", a: article, b: article, wantMin: 1, wantMax: 1},
+		{name: "case and punctuation", a: article, b: strings.ToUpper(strings.ReplaceAll(article, ",", "")), wantMin: 1, wantMax: 1},
+		{name: "small edit", a: article, b: strings.Replace(article, "short", "very short indeed", 1), wantMin: 0.7, wantMax: 1},
+		{name: "unrelated", a: article, b: "Sourdough needs a lively starter, patience and a hot oven to rise well.", wantMin: 0, wantMax: 0.1},
+		{name: "empty", a: article, b: "", wantMin: 0, wantMax: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Similarity(Fingerprint(tt.a), Fingerprint(tt.b))
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("Similarity() = %v, want between %v and %v", got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestIndex(t *testing.T) {
+	x := NewIndex()
+	x.Put("original", Fingerprint(article))
+	x.Put("copy", Fingerprint(article+" Thanks for reading!"))
+	x.Put("other", Fingerprint("Sourdough needs a lively starter, patience and a hot oven to rise well."))
+
+	matches := x.Similar(Fingerprint(article), 0.8, "original")
+	if len(matches) != 1 || matches[0].PostID != "copy" {
+		t.Errorf("Similar() = %v, want only copy", matches)
+	}
+
+	pairs := x.Pairs(0.8)
+	if len(pairs) != 1 || pairs[0].PostID != "copy" || pairs[0].DuplicateID != "original" {
+		t.Errorf("Pairs() = %v, want copy/original", pairs)
+	}
+
+	x.Remove("copy")
+	if pairs := x.Pairs(0.8); len(pairs) != 0 {
+		t.Errorf("Pairs() after Remove = %v, want none", pairs)
+	}
+}
+
+func TestIndex_Bands(t *testing.T) {
+	x := NewIndex()
+	for i := range 200 {
+		x.Put(fmt.Sprintf("post-%03d", i), Fingerprint(fmt.Sprintf("Post number %d covers topic %d in depth with example %d", i, i*7, i*13)))
+	}
+	x.Put("original", Fingerprint(article))
+	x.Put("copy", Fingerprint(article+" Thanks for reading!"))
+	x.Put("empty", Fingerprint(""))
+
+	// Banding finds the same pairs as comparing every post.
+	pairs := x.Pairs(0.8)
+	if len(pairs) != 1 || pairs[0].PostID != "copy" || pairs[0].DuplicateID != "original" {
+		t.Errorf("Pairs() = %v, want copy/original", pairs)
+	}
+	if matches := x.Similar(Fingerprint(article), 0.8, ""); len(matches) != 2 {
+		t.Errorf("Similar() = %v, want original and copy", matches)
+	}
+	if matches := x.Similar(Fingerprint(article), 0.1, "original"); len(matches) != 1 || matches[0].PostID != "copy" {
+		t.Errorf("Similar() below the banded threshold = %v, want copy", matches)
+	}
+
+	// A clone is unaffected by later changes to the original.
+	clone := x.Clone()
+	x.Remove("copy")
+	x.Put("original", Fingerprint("Sourdough needs a lively starter, patience and a hot oven to rise well."))
+	if pairs := x.Pairs(0.8); len(pairs) != 0 {
+		t.Errorf("Pairs() after changes = %v, want none", pairs)
+	}
+	if pairs := clone.Pairs(0.8); len(pairs) != 1 {
+		t.Errorf("clone Pairs() = %v, want copy/original", pairs)
+	}
+}
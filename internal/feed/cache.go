@@ -0,0 +1,91 @@
+package feed
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCacheTTL is how long a merged feed is reused before it is
+	// rebuilt to pick up new posts.
+	DefaultCacheTTL = time.Minute
+	// DefaultCacheUsers is how many users' feeds are cached at once.
+	DefaultCacheUsers = 10000
+)
+
+type cached struct {
+	entries []Entry
+	expires time.Time
+}
+
+// Cache keeps each user's merged feed for a fixed time so that paging
+// through a feed does not merge it again for every page.
+type Cache struct {
+	ttl      time.Duration
+	maxUsers int
+	now      func() time.Time
+
+	mu    sync.Mutex
+	feeds map[string]*cached
+}
+
+// NewCache creates a cache that keeps feeds for ttl and holds at most
+// maxUsers feeds, dropping the ones closest to expiry when full.
+func NewCache(ttl time.Duration, maxUsers int) *Cache {
+	return &Cache{
+		ttl:      ttl,
+		maxUsers: maxUsers,
+		now:      time.Now,
+		feeds:    make(map[string]*cached),
+	}
+}
+
+// Get returns the cached feed of user, or builds it with build and caches
+// the result.
+func (c *Cache) Get(user string, build func() []Entry) []Entry {
+	c.mu.Lock()
+	now := c.now()
+	if f, ok := c.feeds[user]; ok && now.Before(f.expires) {
+		c.mu.Unlock()
+		return f.entries
+	}
+	c.mu.Unlock()
+
+	// Building happens outside the lock; concurrent misses for the same
+	// user may both build, and the last one wins.
+	entries := build()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.feeds[user]; !ok && len(c.feeds) >= c.maxUsers {
+		c.evict(now)
+	}
+	c.feeds[user] = &cached{entries: entries, expires: now.Add(c.ttl)}
+	return entries
+}
+
+// Invalidate drops the cached feed of user, for example after they follow
+// or unfollow a source.
+func (c *Cache) Invalidate(user string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.feeds, user)
+}
+
+// evict drops expired feeds, or the one closest to expiry if none has
+// expired. The caller must hold the lock.
+func (c *Cache) evict(now time.Time) {
+	var oldest string
+	for user, f := range c.feeds {
+		if !now.Before(f.expires) {
+			delete(c.feeds, user)
+			continue
+		}
+		if oldest == "" || f.expires.Before(c.feeds[oldest].expires) {
+			oldest = user
+		}
+	}
+	if len(c.feeds) >= c.maxUsers {
+		delete(c.feeds, oldest)
+	}
+}
@@ -0,0 +1,52 @@
+package feed
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(time.Minute, 2)
+	cache.now = func() time.Time { return now }
+
+	builds := 0
+	build := func(id string) func() []Entry {
+		return func() []Entry {
+			builds++
+			return []Entry{{PostID: id}}
+		}
+	}
+
+	steps := []struct {
+		name       string
+		advance    time.Duration
+		invalidate bool
+		user       string
+		wantBuilds int
+	}{
+		{name: "first read builds", user: "alice", wantBuilds: 1},
+		{name: "second read is cached", advance: 30 * time.Second, user: "alice", wantBuilds: 1},
+		{name: "other users build their own", user: "bob", wantBuilds: 2},
+		{name: "expired feed is rebuilt", advance: 31 * time.Second, user: "alice", wantBuilds: 3},
+		{name: "invalidated feed is rebuilt", invalidate: true, user: "alice", wantBuilds: 4},
+		{name: "full cache evicts", user: "carol", wantBuilds: 5},
+		{name: "evicted user rebuilds", user: "bob", wantBuilds: 6},
+	}
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		if step.invalidate {
+			cache.Invalidate(step.user)
+		}
+		got := cache.Get(step.user, build(step.user))
+		if len(got) != 1 || got[0].PostID != step.user {
+			t.Errorf("%s: Get() = %v", step.name, got)
+		}
+		if builds != step.wantBuilds {
+			t.Errorf("%s: builds = %d, want %d", step.name, builds, step.wantBuilds)
+		}
+	}
+	if len(cache.feeds) > 2 {
+		t.Errorf("cache holds %d feeds, want at most 2", len(cache.feeds))
+	}
+}
@@ -0,0 +1,76 @@
+package feed
+
+import (
+	"container/heap"
+	"time"
+)
+
+// Entry is a post in a feed.
+type Entry struct {
+	PostID    string
+	Published time.Time
+}
+
+// Newer reports whether e sorts before other in a feed: later publication
+// first, ties broken by post ID.
+func (e Entry) Newer(other Entry) bool {
+	if e.Published.Equal(other.Published) {
+		return e.PostID < other.PostID
+	}
+	return e.Published.After(other.Published)
+}
+
+// Merge combines per-source lists, each ordered newest first, into one
+// list of at most limit entries. Posts that appear in several sources are
+// kept once. Only as many entries are read from each source as end up in
+// the result, so a source with many posts costs no more than a small one.
+func Merge(sources [][]Entry, limit int) []Entry {
+	h := make(cursorHeap, 0, len(sources))
+	for _, src := range sources {
+		if len(src) > 0 {
+			h = append(h, &cursor{entries: src})
+		}
+	}
+	heap.Init(&h)
+
+	var result []Entry
+	seen := make(map[string]bool)
+	for h.Len() > 0 && len(result) < limit {
+		c := h[0]
+		e := c.entries[c.pos]
+		if !seen[e.PostID] {
+			seen[e.PostID] = true
+			result = append(result, e)
+		}
+		if c.pos++; c.pos < len(c.entries) {
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+	return result
+}
+
+type cursor struct {
+	entries []Entry
+	pos     int
+}
+
+type cursorHeap []*cursor
+
+func (h cursorHeap) Len() int { return len(h) }
+
+func (h cursorHeap) Less(i, j int) bool {
+	return h[i].entries[h[i].pos].Newer(h[j].entries[h[j].pos])
+}
+
+func (h cursorHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *cursorHeap) Push(x any) { *h = append(*h, x.(*cursor)) }
+
+func (h *cursorHeap) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
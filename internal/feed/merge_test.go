@@ -0,0 +1,75 @@
+package feed
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(id string, hours int) Entry {
+		return Entry{PostID: id, Published: base.Add(time.Duration(hours) * time.Hour)}
+	}
+
+	tests := []struct {
+		name    string
+		sources [][]Entry
+		limit   int
+		want    []string
+	}{
+		{
+			name:  "no sources",
+			limit: 10,
+			want:  nil,
+		},
+		{
+			name: "interleaves sources newest first",
+			sources: [][]Entry{
+				{at("a3", 9), at("a2", 5), at("a1", 1)},
+				{at("b2", 7), at("b1", 3)},
+			},
+			limit: 10,
+			want:  []string{"a3", "b2", "a2", "b1", "a1"},
+		},
+		{
+			name: "keeps posts from several sources once",
+			sources: [][]Entry{
+				{at("x", 4), at("a", 2)},
+				{at("x", 4), at("b", 1)},
+			},
+			limit: 10,
+			want:  []string{"x", "a", "b"},
+		},
+		{
+			name: "stops at the limit",
+			sources: [][]Entry{
+				{at("a3", 9), at("a2", 8), at("a1", 7)},
+				{at("b1", 1)},
+			},
+			limit: 2,
+			want:  []string{"a3", "a2"},
+		},
+		{
+			name: "ties are ordered by post ID",
+			sources: [][]Entry{
+				{at("b", 1)},
+				{at("a", 1)},
+			},
+			limit: 10,
+			want:  []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, e := range Merge(tt.sources, tt.limit) {
+				got = append(got, e.PostID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Merge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrKeyReused is returned when a key is presented again with a request
+// that differs from the one it was first used with.
+var ErrKeyReused = errors.New("idempotency key was already used with a different request")
+
+type entry struct {
+	fingerprint []byte
+	done        chan struct{}
+	result      proto.Message
+	expires     time.Time
+}
+
+// Store remembers the results of successful calls by idempotency key for a
+// fixed window, so that retries return the original result.
+type Store struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu          sync.Mutex
+	entries     map[string]*entry
+	lastCleanup time.Time
+}
+
+func NewStore(window time.Duration) *Store {
+	return &Store{
+		window:  window,
+		now:     time.Now,
+		entries: make(map[string]*entry),
+	}
+}
+
+// Do runs fn once per key. A later call with the same key and an equal
+// request returns the stored result with replayed set, waiting for the
+// first call if it is still running. A call with the same key and a
+// different request fails with ErrKeyReused. Results are only remembered
+// when fn succeeds, so failed calls can be retried under the same key.
+func (s *Store) Do(ctx context.Context, key string, req proto.Message, fn func() (proto.Message, error)) (result proto.Message, replayed bool, err error) {
+	fingerprint, err := fingerprintOf(req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for {
+		s.mu.Lock()
+		now := s.now()
+		s.cleanup(now)
+
+		e, exists := s.entries[key]
+		if exists && e.result != nil && now.After(e.expires) {
+			delete(s.entries, key)
+			exists = false
+		}
+		if exists && !bytes.Equal(e.fingerprint, fingerprint) {
+			s.mu.Unlock()
+			return nil, false, ErrKeyReused
+		}
+		if !exists {
+			e = &entry{fingerprint: fingerprint, done: make(chan struct{})}
+			s.entries[key] = e
+			s.mu.Unlock()
+			return s.run(key, e, fn)
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+
+		s.mu.Lock()
+		result := e.result
+		s.mu.Unlock()
+		if result != nil {
+			return result, true, nil
+		}
+		// The first call failed and released the key; try to claim it.
+	}
+}
+
+func (s *Store) run(key string, e *entry, fn func() (proto.Message, error)) (proto.Message, bool, error) {
+	result, err := fn()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer close(e.done)
+
+	if err != nil {
+		delete(s.entries, key)
+		return nil, false, err
+	}
+	e.result = result
+	e.expires = s.now().Add(s.window)
+	return result, false, nil
+}
+
+// cleanup drops completed entries whose window has passed. It runs at most
+// once per window fraction to keep Do cheap.
+func (s *Store) cleanup(now time.Time) {
+	if now.Sub(s.lastCleanup) < s.window/10 {
+		return
+	}
+	s.lastCleanup = now
+
+	for key, e := range s.entries {
+		if e.result != nil && now.After(e.expires) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+func fingerprintOf(req proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("fingerprint request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
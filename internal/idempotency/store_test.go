@@ -0,0 +1,123 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStore_Replay(t *testing.T) {
+	store := NewStore(time.Hour)
+	ctx := context.Background()
+	req := &pb.ReadPostRequest{PostId: "a"}
+
+	var calls int
+	fn := func() (proto.Message, error) {
+		calls++
+		return &pb.BlogPost{PostId: "post-1"}, nil
+	}
+
+	first, replayed, err := store.Do(ctx, "key", req, fn)
+	if err != nil || replayed {
+		t.Fatalf("Do() first call = %v, %v, want fresh result", replayed, err)
+	}
+
+	second, replayed, err := store.Do(ctx, "key", &pb.ReadPostRequest{PostId: "a"}, fn)
+	if err != nil || !replayed {
+		t.Fatalf("Do() replay = %v, %v, want replayed result", replayed, err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+	if !proto.Equal(first, second) {
+		t.Errorf("Do() replay = %v, want %v", second, first)
+	}
+
+	if _, _, err := store.Do(ctx, "key", &pb.ReadPostRequest{PostId: "b"}, fn); !errors.Is(err, ErrKeyReused) {
+		t.Errorf("Do() with different request error = %v, want ErrKeyReused", err)
+	}
+
+	if _, replayed, err := store.Do(ctx, "other-key", &pb.ReadPostRequest{PostId: "b"}, fn); err != nil || replayed {
+		t.Errorf("Do() with new key = %v, %v, want fresh result", replayed, err)
+	}
+}
+
+func TestStore_FailuresAreNotRemembered(t *testing.T) {
+	store := NewStore(time.Hour)
+	ctx := context.Background()
+	req := &pb.ReadPostRequest{PostId: "a"}
+
+	failure := errors.New("storage unavailable")
+	if _, _, err := store.Do(ctx, "key", req, func() (proto.Message, error) { return nil, failure }); !errors.Is(err, failure) {
+		t.Fatalf("Do() error = %v, want %v", err, failure)
+	}
+
+	_, replayed, err := store.Do(ctx, "key", req, func() (proto.Message, error) { return &pb.BlogPost{}, nil })
+	if err != nil || replayed {
+		t.Errorf("Do() after failure = %v, %v, want fresh result", replayed, err)
+	}
+}
+
+func TestStore_Expiry(t *testing.T) {
+	store := NewStore(time.Hour)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	req := &pb.ReadPostRequest{PostId: "a"}
+	fn := func() (proto.Message, error) { return &pb.BlogPost{}, nil }
+
+	if _, _, err := store.Do(ctx, "key", req, fn); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, replayed, err := store.Do(ctx, "key", &pb.ReadPostRequest{PostId: "b"}, fn); err != nil || replayed {
+		t.Errorf("Do() after window = %v, %v, want fresh result", replayed, err)
+	}
+}
+
+func TestStore_ConcurrentRetries(t *testing.T) {
+	store := NewStore(time.Hour)
+	ctx := context.Background()
+
+	var calls int32
+	release := make(chan struct{})
+	fn := func() (proto.Message, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return &pb.BlogPost{PostId: "post-1"}, nil
+	}
+
+	var wg sync.WaitGroup
+	var replays int32
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, replayed, err := store.Do(ctx, "key", &pb.ReadPostRequest{PostId: "a"}, fn)
+			if err != nil {
+				t.Errorf("Do() error = %v", err)
+			}
+			if replayed {
+				atomic.AddInt32(&replays, 1)
+			}
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+	if replays != 4 {
+		t.Errorf("replays = %d, want 4", replays)
+	}
+}
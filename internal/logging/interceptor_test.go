@@ -0,0 +1,94 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		handlerErr error
+		wantID     string
+		wantCode   string
+		wantLevel  string
+	}{
+		{
+			name:      "propagates request id",
+			md:        metadata.Pairs(RequestIDHeader, "client-id-1"),
+			wantID:    "client-id-1",
+			wantCode:  "OK",
+			wantLevel: "INFO",
+		},
+		{
+			name:       "generates request id and logs errors",
+			md:         nil,
+			handlerErr: status.Error(codes.NotFound, "post not found"),
+			wantCode:   "NotFound",
+			wantLevel:  "WARN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger, err := New(&buf, "json", slog.LevelInfo)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			interceptor := UnaryServerInterceptor(logger)
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var handlerID string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerID, _ = RequestIDFromContext(ctx)
+				return nil, tt.handlerErr
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: "/blog.BlogService/ReadPost"}
+			if _, err := interceptor(ctx, nil, info, handler); err != tt.handlerErr {
+				t.Fatalf("interceptor error = %v, want %v", err, tt.handlerErr)
+			}
+
+			if handlerID == "" {
+				t.Fatal("handler context should carry a request id")
+			}
+			if tt.wantID != "" && handlerID != tt.wantID {
+				t.Errorf("request id = %v, want %v", handlerID, tt.wantID)
+			}
+
+			var record map[string]interface{}
+			if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+				t.Fatalf("log line is not JSON: %v\n%s", err, buf.String())
+			}
+			if record["method"] != info.FullMethod {
+				t.Errorf("method = %v, want %v", record["method"], info.FullMethod)
+			}
+			if record["code"] != tt.wantCode {
+				t.Errorf("code = %v, want %v", record["code"], tt.wantCode)
+			}
+			if record["level"] != tt.wantLevel {
+				t.Errorf("level = %v, want %v", record["level"], tt.wantLevel)
+			}
+			if record["request_id"] != handlerID {
+				t.Errorf("logged request_id = %v, want %v", record["request_id"], handlerID)
+			}
+			if _, ok := record["latency"]; !ok {
+				t.Error("log line should record latency")
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MaxFieldLength is the number of bytes of a string attribute that is kept
+// in a log line. Longer values are cut and annotated with their full size.
+const MaxFieldLength = 256
+
+const redacted = "[REDACTED]"
+
+// redactedKeys lists attribute keys whose values must never be logged.
+var redactedKeys = map[string]bool{
+	"authorization": true,
+	"token":         true,
+	"api_key":       true,
+	"x-api-key":     true,
+	"password":      true,
+	"secret":        true,
+}
+
+// New returns a logger writing to w in the given format, "json" or "text".
+// Every record carries the request and trace IDs found in its context,
+// large string values are truncated and sensitive keys are redacted.
+func New(w io.Writer, format string, level slog.Level) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: replaceAttr,
+	}
+
+	var h slog.Handler
+	switch strings.ToLower(format) {
+	case "json":
+		h = slog.NewJSONHandler(w, opts)
+	case "text":
+		h = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+
+	return slog.New(&contextHandler{Handler: h}), nil
+}
+
+// Truncate shortens s to at most n bytes without splitting a UTF-8
+// sequence, noting the original length when anything was removed.
+func Truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	cut := n
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s...(%d bytes)", s[:cut], len(s))
+}
+
+// untruncatedKeys lists attribute keys that are always logged in full
+// because they are only emitted for rare, serious events.
+var untruncatedKeys = map[string]bool{
+	"stack": true,
+}
+
+func replaceAttr(groups []string, a slog.Attr) slog.Attr {
+	if redactedKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, redacted)
+	}
+	if untruncatedKeys[a.Key] {
+		return a
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		if s := a.Value.String(); len(s) > MaxFieldLength {
+			return slog.String(a.Key, Truncate(s, MaxFieldLength))
+		}
+	case slog.KindAny:
+		if strs, ok := a.Value.Any().([]string); ok {
+			return slog.String(a.Key, Truncate(fmt.Sprint(strs), MaxFieldLength))
+		}
+	}
+	return a
+}
+
+// contextHandler adds the request ID and trace ID found in the record's
+// context.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id, ok := RequestIDFromContext(ctx); ok {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
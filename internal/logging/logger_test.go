@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestNew_Formats(t *testing.T) {
+	for _, format := range []string{"json", "text", "JSON"} {
+		if _, err := New(&bytes.Buffer{}, format, slog.LevelInfo); err != nil {
+			t.Errorf("New(%q) error = %v", format, err)
+		}
+	}
+	if _, err := New(&bytes.Buffer{}, "xml", slog.LevelInfo); err == nil {
+		t.Error("New() expected error for unknown format")
+	}
+}
+
+func TestLogger_Attributes(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, "json", slog.LevelInfo)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := WithRequestID(context.Background(), "req-123")
+	logger.InfoContext(ctx, "Creating post",
+		"title", strings.Repeat("x", 50*1024),
+		"token", "secret-token",
+		"tags", []string{"go", "grpc"},
+		"stack", strings.Repeat("frame\n", 100),
+	)
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("log line is not JSON: %v\n%s", err, buf.String())
+	}
+
+	if record["request_id"] != "req-123" {
+		t.Errorf("request_id = %v, want req-123", record["request_id"])
+	}
+	if title, _ := record["title"].(string); len(title) > MaxFieldLength+32 || !strings.HasSuffix(title, "(51200 bytes)") {
+		t.Errorf("title was not truncated: %d bytes", len(title))
+	}
+	if record["token"] != redacted {
+		t.Errorf("token = %v, want %v", record["token"], redacted)
+	}
+	if stack, _ := record["stack"].(string); len(stack) != 600 {
+		t.Errorf("stack should not be truncated: %d bytes", len(stack))
+	}
+	if record["tags"] != "[go grpc]" {
+		t.Errorf("tags = %v, want [go grpc]", record["tags"])
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{name: "short string", in: "hello", n: 10, want: "hello"},
+		{name: "exact length", in: "hello", n: 5, want: "hello"},
+		{name: "long string", in: "hello world", n: 5, want: "hello...(11 bytes)"},
+		{name: "multi-byte boundary", in: "héllo", n: 2, want: "h...(6 bytes)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Truncate(tt.in, tt.n); got != tt.want {
+				t.Errorf("Truncate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
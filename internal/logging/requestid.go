@@ -0,0 +1,40 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is the metadata key carrying the request ID.
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLength bounds client-supplied IDs so they cannot be used to
+// inflate every log line.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// incomingRequestID returns the client-supplied request ID or a newly
+// generated one when the client sent none or an unusable one.
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(RequestIDHeader); len(values) > 0 {
+		if id := values[0]; id != "" && len(id) <= maxRequestIDLength {
+			return id
+		}
+	}
+	return uuid.New().String()
+}
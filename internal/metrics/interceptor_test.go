@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	m := New()
+	interceptor := UnaryServerInterceptor(m)
+	info := &grpc.UnaryServerInfo{FullMethod: "/blog.BlogService/ReadPost"}
+
+	var inFlight string
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		inFlight = scrape(t, m)
+		return "ok", nil
+	}
+	notFound := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := interceptor(context.Background(), nil, info, ok); err != nil {
+			t.Fatalf("interceptor error = %v", err)
+		}
+	}
+	if _, err := interceptor(context.Background(), nil, info, notFound); status.Code(err) != codes.NotFound {
+		t.Fatalf("interceptor error = %v, want NotFound", err)
+	}
+
+	if !strings.Contains(inFlight, `blog_grpc_requests_in_flight{method="/blog.BlogService/ReadPost"} 1`) {
+		t.Error("in-flight gauge should be 1 while the handler runs")
+	}
+
+	body := scrape(t, m)
+	for _, want := range []string{
+		`blog_grpc_requests_total{code="OK",method="/blog.BlogService/ReadPost"} 2`,
+		`blog_grpc_requests_total{code="NotFound",method="/blog.BlogService/ReadPost"} 1`,
+		`blog_grpc_request_duration_seconds_count{method="/blog.BlogService/ReadPost"} 3`,
+		`blog_grpc_requests_in_flight{method="/blog.BlogService/ReadPost"} 0`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics output missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "blog"
+
+// Metrics holds the Prometheus collectors of the blog server in a registry
+// of its own so that tests can create independent instances.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+	panics   *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_total",
+			Help:      "Number of RPCs handled, by method and status code.",
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Latency of RPCs, by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_in_flight",
+			Help:      "Number of RPCs currently being handled, by method.",
+		}, []string{"method"}),
+		panics: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_panics_total",
+			Help:      "Number of panics recovered while handling RPCs, by method.",
+		}, []string{"method"}),
+	}
+
+	m.registry.MustRegister(
+		m.requests,
+		m.latency,
+		m.inFlight,
+		m.panics,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// RegisterStorage exports gauges describing the contents of s. They are
+// computed when the endpoint is scraped.
+func (m *Metrics) RegisterStorage(s *storage.MemoryStorage) {
+	m.registry.MustRegister(newStorageCollector(s))
+}
+
+// storageCollector reads the storage statistics once per scrape, so that
+// the gauges it reports are consistent with each other.
+type storageCollector struct {
+	storage *storage.MemoryStorage
+	posts   *prometheus.Desc
+	tags    *prometheus.Desc
+}
+
+func newStorageCollector(s *storage.MemoryStorage) *storageCollector {
+	return &storageCollector{
+		storage: s,
+		posts: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "storage", "posts"),
+			"Number of stored posts.", nil, nil,
+		),
+		tags: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "storage", "tags"),
+			"Number of distinct tags across all posts.", nil, nil,
+		),
+	}
+}
+
+func (c *storageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.posts
+	ch <- c.tags
+}
+
+func (c *storageCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.storage.Stats(context.Background())
+	ch <- prometheus.MustNewConstMetric(c.posts, prometheus.GaugeValue, float64(stats.Posts))
+	ch <- prometheus.MustNewConstMetric(c.tags, prometheus.GaugeValue, float64(stats.Tags))
+}
+
+// RecordPanic counts a panic recovered while handling method.
+func (m *Metrics) RecordPanic(method string) {
+	m.panics.WithLabelValues(method).Inc()
+}
+
+// Handler serves the metrics in the Prometheus text exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
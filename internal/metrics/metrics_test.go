@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// scrape returns the text exposition served by m.
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := io.ReadAll(rec.Body)
+	if err != nil {
+		t.Fatalf("Failed to read metrics: %v", err)
+	}
+	return string(body)
+}
+
+func TestMetrics_StorageGauges(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	m := New()
+	m.RegisterStorage(memoryStorage)
+
+	pubDate := timestamppb.New(time.Now())
+	if _, err := memoryStorage.CreatePost(context.Background(), "One", "Content", "Author", pubDate, []string{"go", "grpc"}); err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+	if _, err := memoryStorage.CreatePost(context.Background(), "Two", "Content", "Author", pubDate, []string{"go", "testing"}); err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	body := scrape(t, m)
+	for _, want := range []string{
+		"blog_storage_posts 2",
+		"blog_storage_tags 3",
+		"go_goroutines",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics output missing %q", want)
+		}
+	}
+}
+
+func TestMetrics_RecordPanic(t *testing.T) {
+	m := New()
+	m.RecordPanic("/blog.BlogService/UpdatePost")
+
+	want := `blog_grpc_panics_total{method="/blog.BlogService/UpdatePost"} 1`
+	if body := scrape(t, m); !strings.Contains(body, want) {
+		t.Errorf("metrics output missing %q", want)
+	}
+}
@@ -0,0 +1,68 @@
+package moderation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config holds the auto-moderation rules applied to new and edited comments.
+//
+// Comments with more than MaxLinks links are held for review (a negative
+// value disables the check) and those with more than SpamLinks links are
+// marked as spam (zero disables it). Comments containing any of
+// BlockedWords, matched as whole words regardless of case, are marked as
+// spam. Authors with fewer than NewCommenterHold approved comments have
+// their comments held for review.
+type Config struct {
+	MaxLinks         int      `json:"max_links"`
+	SpamLinks        int      `json:"spam_links"`
+	BlockedWords     []string `json:"blocked_words"`
+	NewCommenterHold int      `json:"new_commenter_hold"`
+}
+
+// DefaultConfig returns rules suitable for a small blog: first-time
+// commenters and comments with several links wait for an editor.
+func DefaultConfig() *Config {
+	return &Config{
+		MaxLinks:         2,
+		SpamLinks:        5,
+		NewCommenterHold: 1,
+	}
+}
+
+// LoadConfig reads a JSON moderation configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read moderation config: %w", err)
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse moderation config: %w", err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (c *Config) validate() error {
+	if c.SpamLinks < 0 {
+		return fmt.Errorf("spam_links must not be negative")
+	}
+	if c.SpamLinks > 0 && c.MaxLinks >= 0 && c.SpamLinks < c.MaxLinks {
+		return fmt.Errorf("spam_links must be at least max_links")
+	}
+	if c.NewCommenterHold < 0 {
+		return fmt.Errorf("new_commenter_hold must not be negative")
+	}
+	for _, word := range c.BlockedWords {
+		if strings.TrimSpace(word) == "" {
+			return fmt.Errorf("blocked_words must not contain empty entries")
+		}
+	}
+	return nil
+}
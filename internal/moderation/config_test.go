@@ -0,0 +1,55 @@
+package moderation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name:    "valid config",
+			data:    `{"max_links": 2, "spam_links": 5, "blocked_words": ["casino"], "new_commenter_hold": 1}`,
+			wantErr: false,
+		},
+		{
+			name:    "spam threshold below hold threshold",
+			data:    `{"max_links": 5, "spam_links": 2}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative hold",
+			data:    `{"new_commenter_hold": -1}`,
+			wantErr: true,
+		},
+		{
+			name:    "empty blocked word",
+			data:    `{"blocked_words": [" "]}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			data:    `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "moderation.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatalf("Failed to write config: %v", err)
+			}
+
+			_, err := LoadConfig(path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package moderation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// Decision is the outcome of applying the rules to a comment.
+type Decision struct {
+	State  proto.ModerationState
+	Reason string
+}
+
+// Moderator applies a Config to comments. It is safe for concurrent use.
+type Moderator struct {
+	config  Config
+	blocked *regexp.Regexp
+}
+
+// NewModerator creates a Moderator for config.
+func NewModerator(config *Config) *Moderator {
+	m := &Moderator{config: *config}
+	if len(config.BlockedWords) > 0 {
+		words := make([]string, len(config.BlockedWords))
+		for i, w := range config.BlockedWords {
+			words[i] = regexp.QuoteMeta(strings.TrimSpace(w))
+		}
+		m.blocked = regexp.MustCompile(`(?i)\b(?:` + strings.Join(words, "|") + `)\b`)
+	}
+	return m
+}
+
+// Evaluate decides the state of a new comment by an author who already has
+// approvedComments approved comments.
+func (m *Moderator) Evaluate(content string, approvedComments int) Decision {
+	if d := m.CheckContent(content); d.State != proto.ModerationState_MODERATION_STATE_APPROVED {
+		return d
+	}
+	if approvedComments < m.config.NewCommenterHold {
+		return Decision{
+			State:  proto.ModerationState_MODERATION_STATE_PENDING,
+			Reason: "comments from new commenters are held for review",
+		}
+	}
+	return Decision{State: proto.ModerationState_MODERATION_STATE_APPROVED}
+}
+
+// CheckContent applies only the content rules. It is used for edits, where
+// the author's history has already been taken into account.
+func (m *Moderator) CheckContent(content string) Decision {
+	if m.blocked != nil {
+		if word := m.blocked.FindString(content); word != "" {
+			return Decision{
+				State:  proto.ModerationState_MODERATION_STATE_SPAM,
+				Reason: fmt.Sprintf("contains blocked word %q", word),
+			}
+		}
+	}
+
+	links := len(linkPattern.FindAllStringIndex(content, -1))
+	if m.config.SpamLinks > 0 && links > m.config.SpamLinks {
+		return Decision{
+			State:  proto.ModerationState_MODERATION_STATE_SPAM,
+			Reason: fmt.Sprintf("contains %d links", links),
+		}
+	}
+	if m.config.MaxLinks >= 0 && links > m.config.MaxLinks {
+		return Decision{
+			State:  proto.ModerationState_MODERATION_STATE_PENDING,
+			Reason: fmt.Sprintf("contains %d links", links),
+		}
+	}
+	return Decision{State: proto.ModerationState_MODERATION_STATE_APPROVED}
+}
@@ -0,0 +1,54 @@
+package moderation
+
+import (
+	"testing"
+
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+func TestModerator_Evaluate(t *testing.T) {
+	m := NewModerator(&Config{
+		MaxLinks:         1,
+		SpamLinks:        3,
+		BlockedWords:     []string{"casino", "cheap pills"},
+		NewCommenterHold: 1,
+	})
+
+	tests := []struct {
+		name     string
+		content  string
+		approved int
+		want     proto.ModerationState
+	}{
+		{name: "regular comment", content: "Great post, thanks!", approved: 2, want: proto.ModerationState_MODERATION_STATE_APPROVED},
+		{name: "new commenter held", content: "Great post, thanks!", approved: 0, want: proto.ModerationState_MODERATION_STATE_PENDING},
+		{name: "one link allowed", content: "See https://example.com", approved: 2, want: proto.ModerationState_MODERATION_STATE_APPROVED},
+		{name: "too many links held", content: "https://a.example and www.b.example", approved: 2, want: proto.ModerationState_MODERATION_STATE_PENDING},
+		{name: "link flood is spam", content: "http://a http://b http://c http://d", approved: 2, want: proto.ModerationState_MODERATION_STATE_SPAM},
+		{name: "blocked word", content: "Visit my CASINO today", approved: 2, want: proto.ModerationState_MODERATION_STATE_SPAM},
+		{name: "blocked phrase", content: "buy cheap pills", approved: 2, want: proto.ModerationState_MODERATION_STATE_SPAM},
+		{name: "blocked word inside other word", content: "casinos are fun", approved: 2, want: proto.ModerationState_MODERATION_STATE_APPROVED},
+		{name: "content rules before hold", content: "casino", approved: 0, want: proto.ModerationState_MODERATION_STATE_SPAM},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := m.Evaluate(tt.content, tt.approved)
+			if got.State != tt.want {
+				t.Errorf("Evaluate() = %v (%s), want %v", got.State, got.Reason, tt.want)
+			}
+			if got.State != proto.ModerationState_MODERATION_STATE_APPROVED && got.Reason == "" {
+				t.Error("Evaluate() expected a reason")
+			}
+		})
+	}
+}
+
+func TestModerator_DisabledLinkRules(t *testing.T) {
+	m := NewModerator(&Config{MaxLinks: -1})
+
+	got := m.CheckContent("http://a http://b http://c http://d http://e http://f")
+	if got.State != proto.ModerationState_MODERATION_STATE_APPROVED {
+		t.Errorf("CheckContent() = %v, want approved", got.State)
+	}
+}
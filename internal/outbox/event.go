@@ -0,0 +1,82 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Post lifecycle event types. The OccurredAt of a published event is the
+// post's publication date; scheduled posts are published once it passes.
+const (
+	EventPostCreated   = "post.created"
+	EventPostUpdated   = "post.updated"
+	EventPostDeleted   = "post.deleted"
+	EventPostPublished = "post.published"
+)
+
+// EventTypes lists every event type.
+var EventTypes = []string{EventPostCreated, EventPostUpdated, EventPostDeleted, EventPostPublished}
+
+// Event is a change to a post. Post is a snapshot of the post after the
+// change, or nil for deletions. Sequence orders the events of one outbox.
+type Event struct {
+	Sequence   uint64
+	ID         string
+	Type       string
+	PostID     string
+	Post       *proto.BlogPost
+	OccurredAt time.Time
+}
+
+// NewEvent returns an event of the given type about post.
+func NewEvent(eventType string, post *proto.BlogPost, at time.Time) Event {
+	return Event{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		PostID:     post.PostId,
+		Post:       post,
+		OccurredAt: at,
+	}
+}
+
+// NewDeletedEvent returns the event for the deletion of a post.
+func NewDeletedEvent(postID string, at time.Time) Event {
+	return Event{
+		ID:         uuid.New().String(),
+		Type:       EventPostDeleted,
+		PostID:     postID,
+		OccurredAt: at,
+	}
+}
+
+type payload struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	PostID     string          `json:"post_id"`
+	Post       json.RawMessage `json:"post,omitempty"`
+}
+
+// Payload returns the JSON encoding of e that sinks deliver. Consumers can
+// use its id to discard events delivered more than once.
+func (e Event) Payload() ([]byte, error) {
+	p := payload{
+		ID:         e.ID,
+		Type:       e.Type,
+		OccurredAt: e.OccurredAt.UTC(),
+		PostID:     e.PostID,
+	}
+	if e.Post != nil {
+		post, err := protojson.Marshal(e.Post)
+		if err != nil {
+			return nil, fmt.Errorf("encode post: %w", err)
+		}
+		p.Post = post
+	}
+	return json.Marshal(p)
+}
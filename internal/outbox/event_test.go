@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+func TestEvent_Payload(t *testing.T) {
+	at := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		event    Event
+		wantPost bool
+	}{
+		{
+			name:     "with post",
+			event:    NewEvent(EventPostCreated, &proto.BlogPost{PostId: "post-1", Title: "Hello"}, at),
+			wantPost: true,
+		},
+		{
+			name:  "deletion",
+			event: NewDeletedEvent("post-1", at),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := tt.event.Payload()
+			if err != nil {
+				t.Fatalf("Payload() error = %v", err)
+			}
+			var got map[string]any
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("Payload() is not JSON: %v", err)
+			}
+			if got["type"] != tt.event.Type || got["post_id"] != "post-1" || got["occurred_at"] != "2024-01-01T12:00:00Z" {
+				t.Errorf("Payload() = %s", body)
+			}
+			if _, ok := got["post"]; ok != tt.wantPost {
+				t.Errorf("Payload() has post = %v, want %v", ok, tt.wantPost)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBatchSize is how many events a sink is given per read of the
+	// outbox.
+	DefaultBatchSize = 100
+	// DefaultPollInterval is how often an idle relay checks the outbox in
+	// case it missed a notification.
+	DefaultPollInterval = time.Second
+
+	initialRetryDelay = 100 * time.Millisecond
+	maxRetryDelay     = 30 * time.Second
+)
+
+// Sink receives events from the relay. Deliver is called with the events of
+// the outbox in order and is retried until it succeeds, so a sink may see an
+// event again after a failure or a restart and should discard repeated
+// event IDs where that matters.
+type Sink interface {
+	Deliver(ctx context.Context, e Event) error
+}
+
+// Source is an outbox that events are recorded in. Each consumer has its
+// own position; events stay in the outbox until every consumer has
+// acknowledged them.
+type Source interface {
+	// RegisterOutboxConsumer starts keeping events for consumer.
+	RegisterOutboxConsumer(ctx context.Context, consumer string)
+	// OutboxEvents returns up to limit events after the last one consumer
+	// acknowledged.
+	OutboxEvents(ctx context.Context, consumer string, limit int) []Event
+	// AckOutbox records that consumer has handled every event up to and
+	// including sequence.
+	AckOutbox(ctx context.Context, consumer string, sequence uint64)
+	// OutboxReady receives a value after new events are recorded.
+	OutboxReady() <-chan struct{}
+}
+
+type namedSink struct {
+	name string
+	sink Sink
+	wake chan struct{}
+}
+
+// Relay delivers the events of an outbox to sinks. Every sink reads the
+// outbox at its own pace, so a failing sink delays only its own events.
+// An event is acknowledged for a sink only after Deliver succeeds, which
+// gives at-least-once delivery.
+type Relay struct {
+	source       Source
+	sinks        []*namedSink
+	batchSize    int
+	pollInterval time.Duration
+	initialRetry time.Duration
+	maxRetry     time.Duration
+}
+
+func NewRelay(source Source) *Relay {
+	return &Relay{
+		source:       source,
+		batchSize:    DefaultBatchSize,
+		pollInterval: DefaultPollInterval,
+		initialRetry: initialRetryDelay,
+		maxRetry:     maxRetryDelay,
+	}
+}
+
+// AddSink registers sink under name, which identifies its position in the
+// outbox and must be stable across restarts. Sinks must be added before
+// Run is called. The name is registered with the source right away, so
+// events are kept for a sink that starts reading after the others have
+// acknowledged them.
+func (r *Relay) AddSink(name string, sink Sink) {
+	r.source.RegisterOutboxConsumer(context.Background(), name)
+	r.sinks = append(r.sinks, &namedSink{name: name, sink: sink, wake: make(chan struct{}, 1)})
+}
+
+// Run delivers events until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for _, s := range r.sinks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.relay(ctx, s)
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.source.OutboxReady():
+			for _, s := range r.sinks {
+				select {
+				case s.wake <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// relay feeds the outbox to one sink, backing off exponentially while the
+// sink fails.
+func (r *Relay) relay(ctx context.Context, s *namedSink) {
+	retry := r.initialRetry
+	for ctx.Err() == nil {
+		events := r.source.OutboxEvents(ctx, s.name, r.batchSize)
+		var failed error
+		for _, e := range events {
+			if failed = s.sink.Deliver(ctx, e); failed != nil {
+				slog.WarnContext(ctx, "Failed to relay event", "sink", s.name, "event_id", e.ID, "event_type", e.Type, "retry_in", retry, "error", failed)
+				break
+			}
+			r.source.AckOutbox(ctx, s.name, e.Sequence)
+			retry = r.initialRetry
+		}
+
+		wait := r.pollInterval
+		wake := s.wake
+		switch {
+		case failed != nil:
+			// New events must not cut the backoff short.
+			wait, wake = retry, nil
+			retry = min(retry*2, r.maxRetry)
+		case len(events) == r.batchSize:
+			continue
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-wake:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
@@ -0,0 +1,159 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memorySource is a Source over a fixed list of events.
+type memorySource struct {
+	mu      sync.Mutex
+	events  []Event
+	cursors map[string]uint64
+	ready   chan struct{}
+	// registered lists the consumers in the order they were registered.
+	registered []string
+}
+
+func newMemorySource() *memorySource {
+	return &memorySource{cursors: make(map[string]uint64), ready: make(chan struct{}, 1)}
+}
+
+func (s *memorySource) add(eventType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := NewDeletedEvent("post", time.Now())
+	e.Type = eventType
+	e.Sequence = uint64(len(s.events) + 1)
+	s.events = append(s.events, e)
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (s *memorySource) RegisterOutboxConsumer(ctx context.Context, consumer string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.registered = append(s.registered, consumer)
+}
+
+func (s *memorySource) OutboxEvents(ctx context.Context, consumer string, limit int) []Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	start := int(s.cursors[consumer])
+	return append([]Event(nil), s.events[start:min(start+limit, len(s.events))]...)
+}
+
+func (s *memorySource) AckOutbox(ctx context.Context, consumer string, sequence uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[consumer] = sequence
+}
+
+func (s *memorySource) OutboxReady() <-chan struct{} {
+	return s.ready
+}
+
+func (s *memorySource) acked(consumer string) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursors[consumer]
+}
+
+// recordingSink fails its first failures calls and records the rest.
+type recordingSink struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	types    []string
+}
+
+func (s *recordingSink) Deliver(ctx context.Context, e Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("sink unavailable")
+	}
+	s.types = append(s.types, e.Type)
+	return nil
+}
+
+func (s *recordingSink) delivered() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.types...)
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRelay(t *testing.T) {
+	source := newMemorySource()
+	healthy := &recordingSink{}
+	flaky := &recordingSink{failures: 3}
+
+	relay := NewRelay(source)
+	relay.batchSize = 2
+	relay.pollInterval = time.Hour
+	relay.initialRetry = time.Millisecond
+	relay.maxRetry = 2 * time.Millisecond
+	relay.AddSink("healthy", healthy)
+	relay.AddSink("flaky", flaky)
+
+	// Sinks are registered before Run so that events are kept for a sink
+	// until it has read them, however late it starts.
+	if want := []string{"healthy", "flaky"}; !reflect.DeepEqual(source.registered, want) {
+		t.Errorf("registered consumers = %v, want %v", source.registered, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	want := []string{EventPostCreated, EventPostPublished, EventPostUpdated}
+	for _, eventType := range want {
+		source.add(eventType)
+	}
+
+	// Both sinks get every event in order; the flaky sink's failures
+	// neither lose events nor hold up the healthy sink.
+	waitFor(t, "healthy sink", func() bool { return source.acked("healthy") == 3 })
+	waitFor(t, "flaky sink", func() bool { return source.acked("flaky") == 3 })
+	if got := healthy.delivered(); !reflect.DeepEqual(got, want) {
+		t.Errorf("healthy sink got %v, want %v", got, want)
+	}
+	if got := flaky.delivered(); !reflect.DeepEqual(got, want) {
+		t.Errorf("flaky sink got %v, want %v", got, want)
+	}
+	flaky.mu.Lock()
+	if flaky.attempts != 6 {
+		t.Errorf("flaky sink attempts = %d, want 6", flaky.attempts)
+	}
+	flaky.mu.Unlock()
+
+	// New events wake the relay without waiting for the poll interval.
+	source.add(EventPostDeleted)
+	waitFor(t, "new event", func() bool { return source.acked("healthy") == 4 })
+}
@@ -0,0 +1,76 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/kpauljoseph/test/internal/broker"
+)
+
+// BrokerSink publishes events to a broker on the subject made of a prefix
+// and the event type, such as "blog.post.created".
+type BrokerSink struct {
+	broker *broker.Broker
+	prefix string
+}
+
+func NewBrokerSink(b *broker.Broker, prefix string) *BrokerSink {
+	return &BrokerSink{
+		broker: b,
+		prefix: prefix,
+	}
+}
+
+func (s *BrokerSink) Deliver(ctx context.Context, e Event) error {
+	data, err := e.Payload()
+	if err != nil {
+		return err
+	}
+	return s.broker.Publish(s.prefix+"."+e.Type, "", data)
+}
+
+// FileSink appends events to a file as JSON lines. Every event is synced
+// to disk before Deliver returns. The file should have a single writer.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens path for appending, creating it if needed.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open event file: %w", err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Deliver(ctx context.Context, e Event) error {
+	data, err := e.Payload()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, err := s.file.Stat()
+	if err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		// Drop a partly written line so that the retry starts on a line
+		// of its own.
+		s.file.Truncate(info.Size())
+		return fmt.Errorf("write event: %w", err)
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
@@ -0,0 +1,70 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/broker"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+func TestBrokerSink(t *testing.T) {
+	b := broker.New()
+	var subjects []string
+	b.Subscribe("blog.post.*", "", func(m broker.Message) { subjects = append(subjects, m.Subject) })
+
+	sink := NewBrokerSink(b, "blog")
+	ctx := context.Background()
+	sink.Deliver(ctx, NewEvent(EventPostCreated, &proto.BlogPost{PostId: "post-1"}, time.Now()))
+	sink.Deliver(ctx, NewDeletedEvent("post-1", time.Now()))
+
+	if len(subjects) != 2 || subjects[0] != "blog.post.created" || subjects[1] != "blog.post.deleted" {
+		t.Errorf("published subjects = %v, want blog.post.created and blog.post.deleted", subjects)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	ctx := context.Background()
+	events := []Event{
+		NewEvent(EventPostCreated, &proto.BlogPost{PostId: "post-1"}, time.Now()),
+		NewDeletedEvent("post-1", time.Now()),
+	}
+
+	// Reopening the file appends to it.
+	for _, e := range events {
+		sink, err := NewFileSink(path)
+		if err != nil {
+			t.Fatalf("NewFileSink() error = %v", err)
+		}
+		if err := sink.Deliver(ctx, e); err != nil {
+			t.Fatalf("Deliver() error = %v", err)
+		}
+		sink.Close()
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open event file: %v", err)
+	}
+	defer f.Close()
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var p struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &p); err != nil {
+			t.Fatalf("line %q is not JSON: %v", scanner.Text(), err)
+		}
+		ids = append(ids, p.ID)
+	}
+	if len(ids) != 2 || ids[0] != events[0].ID || ids[1] != events[1].ID {
+		t.Errorf("event file ids = %v, want the two events in order", ids)
+	}
+}
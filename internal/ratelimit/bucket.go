@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// bucket is a token bucket that refills at rate tokens per second up to
+// burst tokens. It is not safe for concurrent use; the Limiter guards it.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(l Limit, now time.Time) *bucket {
+	return &bucket{
+		tokens: float64(l.Burst),
+		last:   now,
+	}
+}
+
+// take removes one token if available. Otherwise it returns how long the
+// caller has to wait until a token becomes available.
+func (b *bucket) take(l Limit, now time.Time) (bool, time.Duration) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(float64(l.Burst), b.tokens+elapsed.Seconds()*l.Rate)
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	wait := time.Duration((1 - b.tokens) / l.Rate * float64(time.Second))
+	return false, wait
+}
+
+// full reports whether the bucket would be back at its burst size at now,
+// in which case forgetting it changes nothing.
+func (b *bucket) full(l Limit, now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*l.Rate >= float64(l.Burst)
+}
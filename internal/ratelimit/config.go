@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Limit is a token bucket rate: Rate requests per second with bursts of up
+// to Burst requests. A zero Rate disables limiting.
+type Limit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+func (l Limit) unlimited() bool {
+	return l.Rate <= 0
+}
+
+// Config holds the rate limits per RPC and the daily write quota. Methods
+// are full gRPC method names; methods without an entry use Default.
+// DailyWriteQuota caps the number of calls to WriteMethods each client may
+// make per UTC day; zero disables the quota.
+type Config struct {
+	Default         Limit            `json:"default"`
+	Methods         map[string]Limit `json:"methods"`
+	DailyWriteQuota int              `json:"daily_write_quota"`
+	WriteMethods    []string         `json:"write_methods"`
+}
+
+// DefaultConfig returns limits suitable for a single small deployment.
+func DefaultConfig() *Config {
+	return &Config{
+		Default: Limit{Rate: 20, Burst: 40},
+		Methods: map[string]Limit{
+			"/blog.BlogService/CreatePost":    {Rate: 1, Burst: 5},
+			"/blog.BlogService/UpdatePost":    {Rate: 2, Burst: 10},
+			"/blog.BlogService/DeletePost":    {Rate: 2, Burst: 10},
+			"/blog.CommentService/AddComment": {Rate: 1, Burst: 10},
+		},
+		DailyWriteQuota: 500,
+		WriteMethods: []string{
+			"/blog.BlogService/CreatePost",
+			"/blog.BlogService/UpdatePost",
+			"/blog.BlogService/DeletePost",
+			"/blog.CommentService/AddComment",
+			"/blog.CommentService/EditComment",
+			"/blog.CommentService/DeleteComment",
+			"/blog.CommentService/ModerateComment",
+			"/blog.BlogService/ReactToPost",
+			"/blog.BlogService/RemoveReaction",
+			"/blog.BlogService/RecordView",
+			"/blog.TagService/RenameTag",
+			"/blog.TagService/MergeTags",
+			"/blog.CategoryService/CreateCategory",
+			"/blog.CategoryService/MoveCategory",
+			"/blog.SeriesService/CreateSeries",
+			"/blog.SeriesService/AddPostToSeries",
+			"/blog.SeriesService/ReorderSeries",
+			"/blog.AuthorService/CreateAuthor",
+			"/blog.AuthorService/UpdateAuthor",
+			"/blog.FeedService/Follow",
+			"/blog.FeedService/Unfollow",
+			"/blog.WebhookService/CreateWebhook",
+			"/blog.WebhookService/DeleteWebhook",
+			"/blog.WebhookService/RedeliverWebhook",
+			"/blog.AdminService/CreateApiKey",
+			"/blog.AdminService/RevokeApiKey",
+		},
+	}
+}
+
+// LoadConfig reads a JSON rate limit configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read rate limit config: %w", err)
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse rate limit config: %w", err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (c *Config) validate() error {
+	check := func(name string, l Limit) error {
+		if !l.unlimited() && l.Burst < 1 {
+			return fmt.Errorf("limit for %s needs a burst of at least 1", name)
+		}
+		return nil
+	}
+	if err := check("default", c.Default); err != nil {
+		return err
+	}
+	for method, l := range c.Methods {
+		if err := check(method, l); err != nil {
+			return err
+		}
+	}
+	if c.DailyWriteQuota < 0 {
+		return fmt.Errorf("daily_write_quota must not be negative")
+	}
+	return nil
+}
+
+func (c *Config) limitFor(fullMethod string) Limit {
+	if l, ok := c.Methods[fullMethod]; ok {
+		return l
+	}
+	return c.Default
+}
@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid config",
+			data: `{
+				"default": {"rate": 5, "burst": 10},
+				"methods": {"/blog.BlogService/CreatePost": {"rate": 0.5, "burst": 2}},
+				"daily_write_quota": 50,
+				"write_methods": ["/blog.BlogService/CreatePost"]
+			}`,
+			wantErr: false,
+		},
+		{
+			name:    "limit without burst",
+			data:    `{"default": {"rate": 5}}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative quota",
+			data:    `{"daily_write_quota": -1}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			data:    `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ratelimit.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatalf("Failed to write config: %v", err)
+			}
+
+			c, err := LoadConfig(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && c.limitFor(createPost).Rate != 0.5 {
+				t.Errorf("LoadConfig() CreatePost rate = %v, want 0.5", c.limitFor(createPost).Rate)
+			}
+		})
+	}
+}
+
+func TestDefaultConfig_WriteMethods(t *testing.T) {
+	// Every RPC in blog.proto that changes state must count against the
+	// daily write quota, and no read may. RPCs are told apart by name.
+	readPrefixes := []string{"Read", "Get", "List", "Suggest", "Find"}
+
+	c := DefaultConfig()
+	services := proto.File_blog_proto.Services()
+	for i := range services.Len() {
+		service := services.Get(i)
+		methods := service.Methods()
+		for j := range methods.Len() {
+			name := string(methods.Get(j).Name())
+			method := "/" + string(service.FullName()) + "/" + name
+			t.Run(method, func(t *testing.T) {
+				read := slices.ContainsFunc(readPrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) })
+				if listed := slices.Contains(c.WriteMethods, method); listed == read {
+					t.Errorf("DefaultConfig() lists %s as a write method: %v, want %v", method, listed, !read)
+				}
+			})
+		}
+	}
+}
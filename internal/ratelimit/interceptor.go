@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"strconv"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const retryAfterHeader = "retry-after"
+
+// UnaryServerInterceptor refuses calls that exceed their limits with
+// RESOURCE_EXHAUSTED. The delay before a retry can succeed is sent both as
+// a RetryInfo status detail and as a retry-after header in seconds. It
+// should run after authentication so that clients are keyed by identity
+// rather than by address.
+func UnaryServerInterceptor(l *Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.Allow(clientKey(ctx), info.FullMethod); err != nil {
+			return nil, limitStatus(err, func(md metadata.MD) { grpc.SetHeader(ctx, md) })
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor. Limits apply to opening a stream, not to the
+// individual messages on it.
+func StreamServerInterceptor(l *Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.Allow(clientKey(ss.Context()), info.FullMethod); err != nil {
+			return limitStatus(err, func(md metadata.MD) { ss.SetHeader(md) })
+		}
+		return handler(srv, ss)
+	}
+}
+
+// clientKey identifies the caller by authenticated subject, falling back
+// to the peer's IP address for anonymous calls.
+func clientKey(ctx context.Context) string {
+	if id, ok := auth.FromContext(ctx); ok {
+		return "id:" + id.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		return "peer:" + addr
+	}
+	return "unknown"
+}
+
+func limitStatus(err error, setHeader func(metadata.MD)) error {
+	var limitErr *LimitError
+	if !errors.As(err, &limitErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	seconds := int64(math.Ceil(limitErr.RetryAfter.Seconds()))
+	setHeader(metadata.Pairs(retryAfterHeader, strconv.FormatInt(seconds, 10)))
+
+	st := status.New(codes.ResourceExhausted, limitErr.Error())
+	if detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(limitErr.RetryAfter),
+	}); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l, _ := newTestLimiter(&Config{
+		Default: Limit{Rate: 1, Burst: 1},
+	})
+	interceptor := UnaryServerInterceptor(l)
+	info := &grpc.UnaryServerInfo{FullMethod: readPost}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	ctx := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice"})
+	if _, err := interceptor(ctx, nil, info, handler); err != nil {
+		t.Fatalf("interceptor first call error = %v", err)
+	}
+
+	_, err := interceptor(ctx, nil, info, handler)
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("interceptor code = %v, want ResourceExhausted", st.Code())
+	}
+
+	var retry *errdetails.RetryInfo
+	for _, d := range st.Details() {
+		if r, ok := d.(*errdetails.RetryInfo); ok {
+			retry = r
+		}
+	}
+	if retry == nil {
+		t.Fatal("status should carry RetryInfo details")
+	}
+	if d := retry.RetryDelay.AsDuration(); d <= 0 || d > time.Second {
+		t.Errorf("RetryInfo delay = %v, want (0, 1s]", d)
+	}
+}
+
+func TestClientKey(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4242}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "identity",
+			ctx:  auth.NewContext(peer.NewContext(context.Background(), &peer.Peer{Addr: addr}), &auth.Identity{Subject: "alice"}),
+			want: "id:alice",
+		},
+		{
+			name: "peer address without port",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{Addr: addr}),
+			want: "peer:10.0.0.1",
+		},
+		{
+			name: "nothing known",
+			ctx:  context.Background(),
+			want: "unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clientKey(tt.ctx); got != tt.want {
+				t.Errorf("clientKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// cleanupInterval is how often idle buckets are dropped.
+const cleanupInterval = time.Minute
+
+type bucketKey struct {
+	client string
+	method string
+}
+
+type quotaUsage struct {
+	day   string
+	count int
+}
+
+// Limiter applies per-client, per-RPC token buckets and the daily write
+// quota described by a Config.
+type Limiter struct {
+	config *Config
+	writes map[string]bool
+	now    func() time.Time
+
+	mu          sync.Mutex
+	buckets     map[bucketKey]*bucket
+	quotas      map[string]*quotaUsage
+	lastCleanup time.Time
+}
+
+func NewLimiter(config *Config) *Limiter {
+	writes := make(map[string]bool, len(config.WriteMethods))
+	for _, m := range config.WriteMethods {
+		writes[m] = true
+	}
+	return &Limiter{
+		config:  config,
+		writes:  writes,
+		now:     time.Now,
+		buckets: make(map[bucketKey]*bucket),
+		quotas:  make(map[string]*quotaUsage),
+	}
+}
+
+// LimitError is returned when a call is refused. RetryAfter tells the
+// client when trying again can succeed.
+type LimitError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", e.Reason, e.RetryAfter.Round(time.Millisecond))
+}
+
+// Allow records a call by client to fullMethod and returns a *LimitError
+// when the call exceeds its rate limit or the client's daily write quota.
+func (l *Limiter) Allow(client, fullMethod string) error {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.cleanup(now)
+
+	if limit := l.config.limitFor(fullMethod); !limit.unlimited() {
+		key := bucketKey{client: client, method: fullMethod}
+		b, exists := l.buckets[key]
+		if !exists {
+			b = newBucket(limit, now)
+			l.buckets[key] = b
+		}
+		if ok, wait := b.take(limit, now); !ok {
+			return &LimitError{
+				Reason:     fmt.Sprintf("rate limit exceeded for %s", fullMethod),
+				RetryAfter: wait,
+			}
+		}
+	}
+
+	if l.config.DailyWriteQuota > 0 && l.writes[fullMethod] {
+		day := now.UTC().Format("2006-01-02")
+		usage, exists := l.quotas[client]
+		if !exists || usage.day != day {
+			usage = &quotaUsage{day: day}
+			l.quotas[client] = usage
+		}
+		if usage.count >= l.config.DailyWriteQuota {
+			midnight := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+			return &LimitError{
+				Reason:     fmt.Sprintf("daily write quota of %d exhausted", l.config.DailyWriteQuota),
+				RetryAfter: midnight.Sub(now),
+			}
+		}
+		usage.count++
+	}
+
+	return nil
+}
+
+// cleanup drops buckets that have refilled completely and quota counters
+// from previous days so that memory does not grow with every client seen.
+func (l *Limiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < cleanupInterval {
+		return
+	}
+	l.lastCleanup = now
+
+	for key, b := range l.buckets {
+		if b.full(l.config.limitFor(key.method), now) {
+			delete(l.buckets, key)
+		}
+	}
+	day := now.UTC().Format("2006-01-02")
+	for client, usage := range l.quotas {
+		if usage.day != day {
+			delete(l.quotas, client)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+const createPost = "/blog.BlogService/CreatePost"
+const readPost = "/blog.BlogService/ReadPost"
+
+func newTestLimiter(c *Config) (*Limiter, *time.Time) {
+	l := NewLimiter(c)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestLimiter_TokenBucket(t *testing.T) {
+	l, now := newTestLimiter(&Config{
+		Default: Limit{Rate: 1, Burst: 2},
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := l.Allow("alice", readPost); err != nil {
+			t.Fatalf("Allow() call %d error = %v", i, err)
+		}
+	}
+
+	err := l.Allow("alice", readPost)
+	var limitErr *LimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("Allow() error = %v, want *LimitError", err)
+	}
+	if limitErr.RetryAfter <= 0 || limitErr.RetryAfter > time.Second {
+		t.Errorf("Allow() retry after = %v, want (0, 1s]", limitErr.RetryAfter)
+	}
+
+	if err := l.Allow("bob", readPost); err != nil {
+		t.Errorf("Allow() for another client error = %v", err)
+	}
+
+	*now = now.Add(time.Second)
+	if err := l.Allow("alice", readPost); err != nil {
+		t.Errorf("Allow() after refill error = %v", err)
+	}
+}
+
+func TestLimiter_PerMethodLimits(t *testing.T) {
+	l, _ := newTestLimiter(&Config{
+		Default: Limit{},
+		Methods: map[string]Limit{
+			createPost: {Rate: 1, Burst: 1},
+		},
+	})
+
+	if err := l.Allow("alice", createPost); err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if err := l.Allow("alice", createPost); err == nil {
+		t.Error("Allow() expected CreatePost to be limited")
+	}
+	for i := 0; i < 100; i++ {
+		if err := l.Allow("alice", readPost); err != nil {
+			t.Fatalf("Allow() unlimited method error = %v", err)
+		}
+	}
+}
+
+func TestLimiter_DailyWriteQuota(t *testing.T) {
+	l, now := newTestLimiter(&Config{
+		DailyWriteQuota: 2,
+		WriteMethods:    []string{createPost},
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := l.Allow("alice", createPost); err != nil {
+			t.Fatalf("Allow() write %d error = %v", i, err)
+		}
+	}
+
+	err := l.Allow("alice", createPost)
+	var limitErr *LimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("Allow() error = %v, want *LimitError", err)
+	}
+	if limitErr.RetryAfter != 12*time.Hour {
+		t.Errorf("Allow() retry after = %v, want 12h until midnight", limitErr.RetryAfter)
+	}
+
+	if err := l.Allow("alice", readPost); err != nil {
+		t.Errorf("Allow() reads should not count against the quota: %v", err)
+	}
+
+	*now = now.Add(12 * time.Hour)
+	if err := l.Allow("alice", createPost); err != nil {
+		t.Errorf("Allow() on the next day error = %v", err)
+	}
+}
+
+func TestLimiter_Cleanup(t *testing.T) {
+	l, now := newTestLimiter(&Config{
+		Default: Limit{Rate: 1, Burst: 1},
+	})
+
+	if err := l.Allow("alice", readPost); err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+
+	*now = now.Add(2 * cleanupInterval)
+	if err := l.Allow("bob", readPost); err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+
+	if _, exists := l.buckets[bucketKey{client: "alice", method: readPost}]; exists {
+		t.Error("cleanup should drop idle buckets")
+	}
+}
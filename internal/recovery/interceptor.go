@@ -0,0 +1,54 @@
+package recovery
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Hook is called with the full method name after a panic was recovered,
+// for example to count panics in a metric.
+type Hook func(method string)
+
+// UnaryServerInterceptor turns a panic in any later interceptor or handler
+// into an INTERNAL error instead of crashing the process. The panic value
+// and stack are logged with the request's context; hook may be nil.
+func UnaryServerInterceptor(hook Hook) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(ctx, info.FullMethod, p, hook)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(hook Hook) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(ss.Context(), info.FullMethod, p, hook)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recovered(ctx context.Context, method string, p interface{}, hook Hook) error {
+	slog.ErrorContext(ctx, "Recovered from panic",
+		"method", method,
+		"panic", p,
+		"stack", string(debug.Stack()),
+	)
+	if hook != nil {
+		hook(method)
+	}
+	return status.Error(codes.Internal, "internal server error")
+}
@@ -0,0 +1,92 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/kpauljoseph/test/internal/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := logging.New(&buf, "text", slog.LevelInfo)
+	if err != nil {
+		t.Fatalf("logging.New() error = %v", err)
+	}
+	previous := slog.Default()
+	slog.SetDefault(logger)
+	defer slog.SetDefault(previous)
+
+	var hookMethod string
+	interceptor := UnaryServerInterceptor(func(method string) { hookMethod = method })
+	info := &grpc.UnaryServerInfo{FullMethod: "/blog.BlogService/UpdatePost"}
+	ctx := logging.WithRequestID(context.Background(), "req-42")
+
+	t.Run("panicking handler", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			var post *struct{ Title string }
+			return post.Title, nil
+		}
+
+		resp, err := interceptor(ctx, nil, info, handler)
+		if status.Code(err) != codes.Internal {
+			t.Fatalf("interceptor code = %v, want Internal", status.Code(err))
+		}
+		if resp != nil {
+			t.Errorf("interceptor response = %v, want nil", resp)
+		}
+		if hookMethod != info.FullMethod {
+			t.Errorf("hook method = %q, want %q", hookMethod, info.FullMethod)
+		}
+
+		out := buf.String()
+		for _, want := range []string{"request_id=req-42", "nil pointer dereference", "interceptor_test.go"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("log output missing %q:\n%s", want, out)
+			}
+		}
+	})
+
+	t.Run("normal handler", func(t *testing.T) {
+		hookMethod = ""
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+
+		resp, err := interceptor(ctx, nil, info, handler)
+		if err != nil || resp != "ok" {
+			t.Errorf("interceptor = %v, %v, want ok, nil", resp, err)
+		}
+		if hookMethod != "" {
+			t.Error("hook should not be called without a panic")
+		}
+	})
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := StreamServerInterceptor(nil)
+	info := &grpc.StreamServerInfo{FullMethod: "/blog.BlogService/Watch"}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		panic("boom")
+	}
+
+	err := interceptor(nil, &fakeStream{ctx: context.Background()}, info, handler)
+	if status.Code(err) != codes.Internal {
+		t.Errorf("interceptor code = %v, want Internal", status.Code(err))
+	}
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
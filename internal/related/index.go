@@ -0,0 +1,238 @@
+package related
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// Weights blend the three similarity signals into one score. Each signal
+// is between 0 and 1.
+type Weights struct {
+	Tags    float64
+	Content float64
+	Author  float64
+}
+
+// DefaultWeights favours shared tags, then similar content, with a small
+// boost for posts by the same author.
+var DefaultWeights = Weights{Tags: 0.5, Content: 0.4, Author: 0.1}
+
+// Match is a related post and its score.
+type Match struct {
+	PostID string
+	Score  float64
+}
+
+// Index keeps the term and tag statistics needed to rank related posts.
+// Posts are added, replaced and removed as they are written, so a query
+// only visits the posts sharing a tag or term with its post. Related may
+// run concurrently with other calls to Related, but Put and Remove need
+// the caller to hold an exclusive lock.
+type Index struct {
+	weights Weights
+	docs    map[string]*document
+
+	// postings maps each term to the posts containing it and the term's
+	// frequency in them; its length per term is the document frequency.
+	// tagPosts does the same for tags.
+	postings map[string]map[string]int
+	tagPosts map[string]map[string]struct{}
+
+	// norms caches the TF-IDF vector length of each post. Every write
+	// changes the document frequencies the lengths depend on, so writes
+	// clear the cache and queries refill it.
+	normsMu sync.Mutex
+	norms   map[string]float64
+}
+
+type document struct {
+	author string
+	tags   []string
+	terms  map[string]int
+}
+
+// NewIndex creates an empty Index.
+func NewIndex(weights Weights) *Index {
+	return &Index{
+		weights:  weights,
+		docs:     make(map[string]*document),
+		postings: make(map[string]map[string]int),
+		tagPosts: make(map[string]map[string]struct{}),
+		norms:    make(map[string]float64),
+	}
+}
+
+// Put adds a post to the index or replaces its previous version.
+func (x *Index) Put(postID, author, content string, tags []string) {
+	x.Remove(postID)
+
+	doc := &document{
+		author: author,
+		tags:   slices.Compact(slices.Sorted(slices.Values(tags))),
+		terms:  termFrequencies(content),
+	}
+	x.docs[postID] = doc
+	for term, n := range doc.terms {
+		posts, ok := x.postings[term]
+		if !ok {
+			posts = make(map[string]int)
+			x.postings[term] = posts
+		}
+		posts[postID] = n
+	}
+	for _, tag := range doc.tags {
+		posts, ok := x.tagPosts[tag]
+		if !ok {
+			posts = make(map[string]struct{})
+			x.tagPosts[tag] = posts
+		}
+		posts[postID] = struct{}{}
+	}
+	x.norms = make(map[string]float64)
+}
+
+// Remove drops a post from the index.
+func (x *Index) Remove(postID string) {
+	doc, ok := x.docs[postID]
+	if !ok {
+		return
+	}
+	delete(x.docs, postID)
+	for term := range doc.terms {
+		delete(x.postings[term], postID)
+		if len(x.postings[term]) == 0 {
+			delete(x.postings, term)
+		}
+	}
+	for _, tag := range doc.tags {
+		delete(x.tagPosts[tag], postID)
+		if len(x.tagPosts[tag]) == 0 {
+			delete(x.tagPosts, tag)
+		}
+	}
+	x.norms = make(map[string]float64)
+}
+
+// Related returns up to limit other posts most similar to postID, highest
+// score first. Posts with nothing in common are left out.
+func (x *Index) Related(postID string, limit int) []Match {
+	doc, ok := x.docs[postID]
+	if !ok {
+		return nil
+	}
+
+	tags := x.tagScores(postID, doc)
+	content := x.contentScores(postID, doc)
+
+	scores := make(map[string]float64)
+	for id, s := range tags {
+		scores[id] += x.weights.Tags * s
+	}
+	for id, s := range content {
+		scores[id] += x.weights.Content * s
+	}
+	// Authorship alone does not make a post related, so it only boosts
+	// posts that already share tags or content.
+	for id := range scores {
+		if x.docs[id].author == doc.author {
+			scores[id] += x.weights.Author
+		}
+	}
+
+	matches := make([]Match, 0, len(scores))
+	for id, s := range scores {
+		matches = append(matches, Match{PostID: id, Score: s})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score == matches[j].Score {
+			return matches[i].PostID < matches[j].PostID
+		}
+		return matches[i].Score > matches[j].Score
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// tagScores returns, for every post sharing a tag with doc, the share of
+// doc's tag weight it covers. Tags are weighted by rarity so that sharing
+// a niche tag counts for more than sharing a popular one.
+func (x *Index) tagScores(postID string, doc *document) map[string]float64 {
+	var total float64
+	for _, tag := range doc.tags {
+		total += x.idf(len(x.tagPosts[tag]))
+	}
+	if total == 0 {
+		return nil
+	}
+
+	scores := make(map[string]float64)
+	for _, tag := range doc.tags {
+		w := x.idf(len(x.tagPosts[tag]))
+		for id := range x.tagPosts[tag] {
+			if id != postID {
+				scores[id] += w / total
+			}
+		}
+	}
+	return scores
+}
+
+// contentScores returns the TF-IDF cosine similarity between doc and every
+// post sharing at least one term with it.
+func (x *Index) contentScores(postID string, doc *document) map[string]float64 {
+	dots := make(map[string]float64)
+	for term, n := range doc.terms {
+		idf := x.idf(len(x.postings[term]))
+		w := float64(n) * idf
+		for id, m := range x.postings[term] {
+			if id != postID {
+				dots[id] += w * float64(m) * idf
+			}
+		}
+	}
+	if len(dots) == 0 {
+		return nil
+	}
+
+	norm := x.norm(postID)
+	scores := make(map[string]float64, len(dots))
+	for id, dot := range dots {
+		if n := norm * x.norm(id); n > 0 {
+			scores[id] = dot / n
+		}
+	}
+	return scores
+}
+
+// norm returns the length of a post's TF-IDF vector, computing it only
+// once between writes.
+func (x *Index) norm(postID string) float64 {
+	x.normsMu.Lock()
+	defer x.normsMu.Unlock()
+	if norm, ok := x.norms[postID]; ok {
+		return norm
+	}
+
+	var sum float64
+	for term, n := range x.docs[postID].terms {
+		w := float64(n) * x.idf(len(x.postings[term]))
+		sum += w * w
+	}
+	norm := math.Sqrt(sum)
+	x.norms[postID] = norm
+	return norm
+}
+
+// idf is the smoothed inverse document frequency of something found in df
+// posts. Smoothing keeps things every post has from dropping to zero, which
+// matters while there are only a handful of posts.
+func (x *Index) idf(df int) float64 {
+	if df == 0 {
+		return 0
+	}
+	return math.Log(1 + float64(len(x.docs))/float64(df))
+}
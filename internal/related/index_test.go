@@ -0,0 +1,112 @@
+package related
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTermFrequencies(t *testing.T) {
+	got := termFrequencies("The Go scheduler: goroutines, go-routines and GO!")
+	want := map[string]int{"go": 3, "scheduler": 1, "goroutines": 1, "routines": 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("termFrequencies() = %v, want %v", got, want)
+	}
+}
+
+func TestIndex_Related(t *testing.T) {
+	x := NewIndex(DefaultWeights)
+	x.Put("gc", "alice", "Tuning the Go garbage collector for low latency services", []string{"go", "performance"})
+	x.Put("sched", "bob", "How the Go scheduler multiplexes goroutines onto threads", []string{"go", "concurrency"})
+	x.Put("latency", "carol", "Reducing tail latency in services with garbage collector tuning", []string{"performance"})
+	x.Put("bread", "alice", "Sourdough bread needs patience and a warm kitchen", []string{"baking"})
+
+	got := x.Related("gc", 10)
+	if len(got) != 2 {
+		t.Fatalf("Related() = %v, want 2 matches", got)
+	}
+	if got[0].PostID != "latency" || got[1].PostID != "sched" {
+		t.Errorf("Related() order = %v, want latency then sched", got)
+	}
+	if got[0].Score <= got[1].Score {
+		t.Errorf("Related() scores not descending: %v", got)
+	}
+
+	if got := x.Related("gc", 1); len(got) != 1 {
+		t.Errorf("Related() with limit 1 returned %d matches", len(got))
+	}
+	if got := x.Related("missing", 10); got != nil {
+		t.Errorf("Related() for unknown post = %v, want nil", got)
+	}
+}
+
+func TestIndex_RareTagsWeighMore(t *testing.T) {
+	x := NewIndex(Weights{Tags: 1})
+	x.Put("a", "alice", "", []string{"go", "wasm"})
+	x.Put("common", "bob", "", []string{"go"})
+	x.Put("rare", "bob", "", []string{"wasm"})
+	x.Put("other1", "bob", "", []string{"go"})
+	x.Put("other2", "bob", "", []string{"go"})
+
+	got := x.Related("a", 10)
+	if len(got) == 0 || got[0].PostID != "rare" {
+		t.Errorf("Related() = %v, want rare first", got)
+	}
+}
+
+func TestIndex_AuthorOnlyBoostsRelatedPosts(t *testing.T) {
+	x := NewIndex(DefaultWeights)
+	x.Put("a", "alice", "kubernetes operators", []string{"k8s"})
+	x.Put("b", "alice", "sourdough starter", []string{"baking"})
+	x.Put("c", "bob", "kubernetes controllers", []string{"k8s"})
+	x.Put("d", "alice", "kubernetes controllers", []string{"k8s"})
+
+	got := x.Related("a", 10)
+	if len(got) != 2 || got[0].PostID != "d" || got[1].PostID != "c" {
+		t.Errorf("Related() = %v, want d then c without b", got)
+	}
+}
+
+func TestIndex_PutReplacesAndRemove(t *testing.T) {
+	x := NewIndex(DefaultWeights)
+	x.Put("a", "alice", "rust ownership", []string{"rust"})
+	x.Put("b", "bob", "rust borrow checker", []string{"rust"})
+
+	x.Put("b", "bob", "python decorators", []string{"python"})
+	if got := x.Related("a", 10); len(got) != 0 {
+		t.Errorf("Related() after update = %v, want none", got)
+	}
+
+	x.Put("c", "bob", "rust lifetimes", []string{"rust"})
+	x.Remove("c")
+	if got := x.Related("a", 10); len(got) != 0 {
+		t.Errorf("Related() after remove = %v, want none", got)
+	}
+	if len(x.postings["rust"]) != 1 || len(x.tagPosts["rust"]) != 1 {
+		t.Errorf("statistics not updated: postings=%v tags=%v", x.postings["rust"], x.tagPosts)
+	}
+}
+
+func TestIndex_CachedNormsFollowWrites(t *testing.T) {
+	docs := []struct {
+		id, author, content string
+		tags                []string
+	}{
+		{id: "a", author: "alice", content: "go channels and goroutines", tags: []string{"go"}},
+		{id: "b", author: "bob", content: "go channels for pipelines", tags: []string{"go"}},
+		{id: "c", author: "carol", content: "pipelines in python", tags: []string{"python"}},
+	}
+
+	// Querying between writes must give the same scores as an index that
+	// saw every write before its first query.
+	cached := NewIndex(DefaultWeights)
+	fresh := NewIndex(DefaultWeights)
+	for _, d := range docs {
+		cached.Put(d.id, d.author, d.content, d.tags)
+		cached.Related("a", 10)
+		fresh.Put(d.id, d.author, d.content, d.tags)
+	}
+	got, want := cached.Related("a", 10), fresh.Related("a", 10)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Related() with cached norms = %v, want %v", got, want)
+	}
+}
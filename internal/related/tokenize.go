@@ -0,0 +1,35 @@
+package related
+
+import (
+	"strings"
+	"unicode"
+)
+
+// stopWords are common English words that carry no topical signal.
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "but": {},
+	"by": {}, "for": {}, "from": {}, "has": {}, "have": {}, "how": {}, "if": {}, "in": {},
+	"into": {}, "is": {}, "it": {}, "its": {}, "not": {}, "of": {}, "on": {}, "or": {},
+	"so": {}, "that": {}, "the": {}, "their": {}, "then": {}, "there": {}, "these": {},
+	"this": {}, "to": {}, "was": {}, "we": {}, "were": {}, "what": {}, "when": {},
+	"which": {}, "will": {}, "with": {}, "you": {}, "your": {},
+}
+
+// termFrequencies splits text into lower-case words, dropping stop words
+// and single characters, and counts each word.
+func termFrequencies(text string) map[string]int {
+	tf := make(map[string]int)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, w := range words {
+		if len([]rune(w)) < 2 {
+			continue
+		}
+		if _, stop := stopWords[w]; stop {
+			continue
+		}
+		tf[w]++
+	}
+	return tf
+}
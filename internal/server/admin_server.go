@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AdminServer struct {
+	proto.UnimplementedAdminServiceServer
+	apiKeys *auth.APIKeyStore
+	policy  *authz.Policy
+	storage *storage.MemoryStorage
+}
+
+// NewAdminServer creates an AdminServer. When policy is non-nil, API key
+// scopes must name roles defined in it.
+func NewAdminServer(apiKeys *auth.APIKeyStore, policy *authz.Policy, storage *storage.MemoryStorage) *AdminServer {
+	return &AdminServer{
+		apiKeys: apiKeys,
+		policy:  policy,
+		storage: storage,
+	}
+}
+
+func (s *AdminServer) CreateApiKey(ctx context.Context, req *proto.CreateApiKeyRequest) (*proto.CreateApiKeyResponse, error) {
+	ctx, span := tracer.Start(ctx, "AdminServer.CreateApiKey")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Creating API key", "name", req.Name, "scopes", req.Scopes)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.CreateApiKeyResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if s.policy != nil {
+		for _, scope := range req.Scopes {
+			if _, ok := s.policy.Roles[scope]; !ok {
+				return &proto.CreateApiKeyResponse{
+					Error: fmt.Sprintf("unknown scope %q", scope),
+				}, nil
+			}
+		}
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+		if !expiresAt.After(time.Now()) {
+			return &proto.CreateApiKeyResponse{
+				Error: "expires_at must be in the future",
+			}, nil
+		}
+	}
+
+	var createdBy string
+	if id, ok := auth.FromContext(ctx); ok {
+		createdBy = id.Subject
+	}
+
+	key, plaintext, err := s.apiKeys.Create(req.Name, req.Scopes, createdBy, expiresAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create API key", "error", err)
+		return &proto.CreateApiKeyResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "API key created successfully", "key_id", key.ID)
+	return &proto.CreateApiKeyResponse{
+		ApiKey: apiKeyToProto(key),
+		Key:    plaintext,
+	}, nil
+}
+
+func (s *AdminServer) ListApiKeys(ctx context.Context, req *proto.ListApiKeysRequest) (*proto.ListApiKeysResponse, error) {
+	ctx, span := tracer.Start(ctx, "AdminServer.ListApiKeys")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing API keys")
+
+	keys := s.apiKeys.List()
+	resp := &proto.ListApiKeysResponse{
+		ApiKeys: make([]*proto.ApiKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyToProto(key))
+	}
+	return resp, nil
+}
+
+func (s *AdminServer) RevokeApiKey(ctx context.Context, req *proto.RevokeApiKeyRequest) (*proto.RevokeApiKeyResponse, error) {
+	ctx, span := tracer.Start(ctx, "AdminServer.RevokeApiKey")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Revoking API key", "key_id", req.KeyId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.RevokeApiKeyResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	if err := s.apiKeys.Revoke(req.KeyId); err != nil {
+		slog.WarnContext(ctx, "Failed to revoke API key", "key_id", req.KeyId, "error", err)
+		return &proto.RevokeApiKeyResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "API key revoked successfully", "key_id", req.KeyId)
+	return &proto.RevokeApiKeyResponse{
+		Success: true,
+	}, nil
+}
+
+func apiKeyToProto(key *auth.APIKey) *proto.ApiKey {
+	pb := &proto.ApiKey{
+		KeyId:     key.ID,
+		Name:      key.Name,
+		Scopes:    key.Scopes,
+		CreatedBy: key.CreatedBy,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+		Revoked:   key.Revoked,
+	}
+	if !key.ExpiresAt.IsZero() {
+		pb.ExpiresAt = timestamppb.New(key.ExpiresAt)
+	}
+	return pb
+}
+
+func (s *AdminServer) FindDuplicates(ctx context.Context, req *proto.FindDuplicatesRequest) (*proto.FindDuplicatesResponse, error) {
+	ctx, span := tracer.Start(ctx, "AdminServer.FindDuplicates")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Finding duplicate posts", "threshold", req.Threshold)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.FindDuplicatesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	resp := &proto.FindDuplicatesResponse{}
+	for _, p := range s.storage.DuplicatePairs(ctx, req.Threshold) {
+		resp.Pairs = append(resp.Pairs, &proto.DuplicatePair{
+			PostId:          p.PostID,
+			DuplicatePostId: p.DuplicateID,
+			Similarity:      p.Similarity,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAdminServer_CreateApiKey(t *testing.T) {
+	server := NewAdminServer(auth.NewAPIKeyStore(), authz.DefaultPolicy(), storage.NewMemoryStorage())
+	ctx := auth.NewContext(context.Background(), &auth.Identity{Subject: "admin-1", Roles: []string{"admin"}})
+
+	tests := []struct {
+		name    string
+		req     *proto.CreateApiKeyRequest
+		wantErr bool
+	}{
+		{
+			name:    "valid key",
+			req:     &proto.CreateApiKeyRequest{Name: "batch", Scopes: []string{"author"}},
+			wantErr: false,
+		},
+		{
+			name: "valid key with expiry",
+			req: &proto.CreateApiKeyRequest{
+				Name:      "batch",
+				Scopes:    []string{"reader"},
+				ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing name",
+			req:     &proto.CreateApiKeyRequest{Scopes: []string{"author"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing scopes",
+			req:     &proto.CreateApiKeyRequest{Name: "batch"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown scope",
+			req:     &proto.CreateApiKeyRequest{Name: "batch", Scopes: []string{"superuser"}},
+			wantErr: true,
+		},
+		{
+			name: "expiry in the past",
+			req: &proto.CreateApiKeyRequest{
+				Name:      "batch",
+				Scopes:    []string{"author"},
+				ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.CreateApiKey(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("CreateApiKey() error = %v", err)
+			}
+
+			if tt.wantErr {
+				if resp.Error == "" {
+					t.Error("CreateApiKey() expected error but got none")
+				}
+				return
+			}
+			if resp.Error != "" {
+				t.Fatalf("CreateApiKey() unexpected error: %s", resp.Error)
+			}
+			if resp.Key == "" || resp.ApiKey.GetKeyId() == "" {
+				t.Error("CreateApiKey() should return the key and its ID")
+			}
+			if resp.ApiKey.CreatedBy != "admin-1" {
+				t.Errorf("CreateApiKey() created_by = %v, want admin-1", resp.ApiKey.CreatedBy)
+			}
+			if (tt.req.ExpiresAt == nil) != (resp.ApiKey.ExpiresAt == nil) {
+				t.Errorf("CreateApiKey() expires_at = %v, want %v", resp.ApiKey.ExpiresAt, tt.req.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestAdminServer_ListAndRevoke(t *testing.T) {
+	apiKeys := auth.NewAPIKeyStore()
+	server := NewAdminServer(apiKeys, nil, storage.NewMemoryStorage())
+	ctx := context.Background()
+
+	createResp, err := server.CreateApiKey(ctx, &proto.CreateApiKeyRequest{Name: "batch", Scopes: []string{"author"}})
+	if err != nil || createResp.Error != "" {
+		t.Fatalf("CreateApiKey() error = %v, %s", err, createResp.GetError())
+	}
+
+	listResp, err := server.ListApiKeys(ctx, &proto.ListApiKeysRequest{})
+	if err != nil {
+		t.Fatalf("ListApiKeys() error = %v", err)
+	}
+	if len(listResp.ApiKeys) != 1 || listResp.ApiKeys[0].KeyId != createResp.ApiKey.KeyId {
+		t.Fatalf("ListApiKeys() = %v, want the created key", listResp.ApiKeys)
+	}
+
+	revokeResp, err := server.RevokeApiKey(ctx, &proto.RevokeApiKeyRequest{KeyId: createResp.ApiKey.KeyId})
+	if err != nil || !revokeResp.Success {
+		t.Fatalf("RevokeApiKey() error = %v, %s", err, revokeResp.GetError())
+	}
+	if _, err := apiKeys.Authenticate(createResp.Key); err == nil {
+		t.Error("Authenticate() should fail for a revoked key")
+	}
+
+	missing, err := server.RevokeApiKey(ctx, &proto.RevokeApiKeyRequest{KeyId: "non-existent-id"})
+	if err != nil {
+		t.Fatalf("RevokeApiKey() error = %v", err)
+	}
+	if missing.Success || missing.Error == "" {
+		t.Error("RevokeApiKey() should fail for unknown key")
+	}
+}
+
+func TestAdminServer_FindDuplicates(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewAdminServer(auth.NewAPIKeyStore(), nil, memoryStorage)
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	content := "A practical guide to structured logging with slog in Go services and how to add request context"
+	first, _ := memoryStorage.CreatePost(ctx, "Logging", content, "alice", date, nil)
+	second, _ := memoryStorage.CreatePost(ctx, "Logging again", content, "alice", date, nil)
+	memoryStorage.CreatePost(ctx, "Other", "Something else entirely about sourdough baking at home", "bob", date, nil)
+
+	resp, err := server.FindDuplicates(ctx, &proto.FindDuplicatesRequest{})
+	if err != nil || resp.Error != "" {
+		t.Fatalf("FindDuplicates() error = %v, %s", err, resp.GetError())
+	}
+	if len(resp.Pairs) != 1 {
+		t.Fatalf("FindDuplicates() = %v, want one pair", resp.Pairs)
+	}
+	pair := resp.Pairs[0]
+	ids := map[string]bool{pair.PostId: true, pair.DuplicatePostId: true}
+	if !ids[first.PostId] || !ids[second.PostId] || pair.Similarity != 1 {
+		t.Errorf("FindDuplicates() pair = %v, want %s and %s fully similar", pair, first.PostId, second.PostId)
+	}
+
+	invalid, err := server.FindDuplicates(ctx, &proto.FindDuplicatesRequest{Threshold: 1.5})
+	if err != nil || invalid.Error == "" {
+		t.Errorf("FindDuplicates() with threshold 1.5 = %v, %v, want error in response", invalid, err)
+	}
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+type AuthorServer struct {
+	proto.UnimplementedAuthorServiceServer
+	storage *storage.MemoryStorage
+	policy  *authz.Policy
+}
+
+// NewAuthorServer creates an AuthorServer. When policy is set, only the
+// author, or a role allowed to modify anything, may update a profile.
+func NewAuthorServer(storage *storage.MemoryStorage, policy *authz.Policy) *AuthorServer {
+	return &AuthorServer{
+		storage: storage,
+		policy:  policy,
+	}
+}
+
+func (s *AuthorServer) CreateAuthor(ctx context.Context, req *proto.CreateAuthorRequest) (*proto.CreateAuthorResponse, error) {
+	ctx, span := tracer.Start(ctx, "AuthorServer.CreateAuthor")
+	defer span.End()
+
+	authorID := callerAuthor(ctx, req.AuthorId)
+	slog.InfoContext(ctx, "Creating author", "author_id", authorID)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.CreateAuthorResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if authorID == "" {
+		return &proto.CreateAuthorResponse{
+			Error: "author_id is required",
+		}, nil
+	}
+
+	author, err := s.storage.CreateAuthor(ctx, &proto.Author{
+		AuthorId:    authorID,
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+		AvatarUrl:   req.AvatarUrl,
+		SocialLinks: req.SocialLinks,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to create author", "author_id", authorID, "error", err)
+		return &proto.CreateAuthorResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Author created successfully", "author_id", author.AuthorId)
+	return &proto.CreateAuthorResponse{
+		Author: author,
+	}, nil
+}
+
+func (s *AuthorServer) GetAuthor(ctx context.Context, req *proto.GetAuthorRequest) (*proto.GetAuthorResponse, error) {
+	ctx, span := tracer.Start(ctx, "AuthorServer.GetAuthor")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Getting author", "author_id", req.AuthorId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.GetAuthorResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	author, err := s.storage.GetAuthor(ctx, req.AuthorId)
+	if err != nil {
+		slog.WarnContext(ctx, "Author not found", "author_id", req.AuthorId, "error", err)
+		return &proto.GetAuthorResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	return &proto.GetAuthorResponse{
+		Author: author,
+	}, nil
+}
+
+func (s *AuthorServer) UpdateAuthor(ctx context.Context, req *proto.UpdateAuthorRequest) (*proto.UpdateAuthorResponse, error) {
+	ctx, span := tracer.Start(ctx, "AuthorServer.UpdateAuthor")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Updating author", "author_id", req.AuthorId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.UpdateAuthorResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	// A profile belongs to the identity whose subject is its ID.
+	if err := checkOwner(ctx, s.policy, "author", req.AuthorId, req.AuthorId); err != nil {
+		slog.WarnContext(ctx, "Author update denied", "author_id", req.AuthorId, "error", err)
+		return nil, err
+	}
+
+	author, err := s.storage.UpdateAuthor(ctx, &proto.Author{
+		AuthorId:    req.AuthorId,
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+		AvatarUrl:   req.AvatarUrl,
+		SocialLinks: req.SocialLinks,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to update author", "author_id", req.AuthorId, "error", err)
+		return &proto.UpdateAuthorResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Author updated successfully", "author_id", author.AuthorId)
+	return &proto.UpdateAuthorResponse{
+		Author: author,
+	}, nil
+}
+
+func (s *AuthorServer) ListAuthors(ctx context.Context, req *proto.ListAuthorsRequest) (*proto.ListAuthorsResponse, error) {
+	ctx, span := tracer.Start(ctx, "AuthorServer.ListAuthors")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing authors")
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return &proto.ListAuthorsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	authors, next := paginate(s.storage.ListAuthors(ctx), offset, int(req.PageSize))
+	return &proto.ListAuthorsResponse{
+		Authors:       authors,
+		NextPageToken: next,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAuthorServer(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	policy := authz.DefaultPolicy()
+	authorServer := NewAuthorServer(memoryStorage, policy)
+	alice := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice", Roles: []string{"author"}})
+	bob := auth.NewContext(context.Background(), &auth.Identity{Subject: "bob", Roles: []string{"author"}})
+
+	created, err := authorServer.CreateAuthor(alice, &proto.CreateAuthorRequest{
+		AuthorId:    "someone-else",
+		DisplayName: "Alice",
+		AvatarUrl:   "https://example.com/alice.png",
+	})
+	if err != nil || created.Error != "" {
+		t.Fatalf("CreateAuthor() = %v, %v", created, err)
+	}
+	if created.Author.AuthorId != "alice" {
+		t.Errorf("CreateAuthor() id = %s, want alice", created.Author.AuthorId)
+	}
+
+	invalid, err := authorServer.CreateAuthor(bob, &proto.CreateAuthorRequest{DisplayName: "Bob", AvatarUrl: "ftp://example.com/bob.png"})
+	if err != nil || invalid.Error == "" {
+		t.Errorf("CreateAuthor() with invalid avatar URL = %v, %v, want error in response", invalid, err)
+	}
+
+	update := &proto.UpdateAuthorRequest{AuthorId: "alice", DisplayName: "Alice Smith"}
+	if _, err := authorServer.UpdateAuthor(bob, update); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateAuthor() by someone else error = %v, want PermissionDenied", err)
+	}
+	updated, err := authorServer.UpdateAuthor(alice, update)
+	if err != nil || updated.Error != "" || updated.Author.DisplayName != "Alice Smith" {
+		t.Errorf("UpdateAuthor() = %v, %v", updated, err)
+	}
+
+	got, err := authorServer.GetAuthor(bob, &proto.GetAuthorRequest{AuthorId: "alice"})
+	if err != nil || got.Error != "" || got.Author.DisplayName != "Alice Smith" {
+		t.Errorf("GetAuthor() = %v, %v", got, err)
+	}
+	missing, err := authorServer.GetAuthor(bob, &proto.GetAuthorRequest{AuthorId: "missing"})
+	if err != nil || missing.Error == "" {
+		t.Errorf("GetAuthor() of missing author = %v, %v, want error in response", missing, err)
+	}
+
+	authorServer.CreateAuthor(bob, &proto.CreateAuthorRequest{DisplayName: "Bob"})
+	list, err := authorServer.ListAuthors(bob, &proto.ListAuthorsRequest{PageSize: 1})
+	if err != nil || len(list.Authors) != 1 || list.Authors[0].AuthorId != "alice" || list.NextPageToken == "" {
+		t.Fatalf("ListAuthors() first page = %v, %v", list, err)
+	}
+	list, err = authorServer.ListAuthors(bob, &proto.ListAuthorsRequest{PageSize: 1, PageToken: list.NextPageToken})
+	if err != nil || len(list.Authors) != 1 || list.Authors[0].AuthorId != "bob" || list.NextPageToken != "" {
+		t.Errorf("ListAuthors() second page = %v, %v", list, err)
+	}
+}
+
+func TestBlogServer_CoAuthors(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	policy := authz.DefaultPolicy()
+	server := NewBlogServer(memoryStorage, WithPolicy(policy))
+	authorServer := NewAuthorServer(memoryStorage, policy)
+	alice := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice", Roles: []string{"author"}})
+	bob := auth.NewContext(context.Background(), &auth.Identity{Subject: "bob", Roles: []string{"author"}})
+
+	authorServer.CreateAuthor(bob, &proto.CreateAuthorRequest{DisplayName: "Bob"})
+
+	unknown, err := server.CreatePost(alice, &proto.CreatePostRequest{
+		Title:           "Post",
+		Content:         "Content",
+		PublicationDate: timestamppb.New(time.Now()),
+		CoAuthorIds:     []string{"carol"},
+	})
+	if err != nil || unknown.Error == "" {
+		t.Errorf("CreatePost() with unknown co-author = %v, %v, want error in response", unknown, err)
+	}
+
+	created, err := server.CreatePost(alice, &proto.CreatePostRequest{
+		Title:           "Post",
+		Content:         "Content",
+		PublicationDate: timestamppb.New(time.Now()),
+		CoAuthorIds:     []string{"bob"},
+	})
+	if err != nil || created.Error != "" {
+		t.Fatalf("CreatePost() = %v, %v", created, err)
+	}
+	postID := created.Post.PostId
+
+	updated, err := server.UpdatePost(bob, &proto.UpdatePostRequest{
+		PostId:      postID,
+		Title:       "Edited by Bob",
+		Content:     "Content",
+		Author:      "bob",
+		CoAuthorIds: []string{"bob"},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"co_author_ids"}},
+	})
+	if err != nil || updated.Error != "" {
+		t.Fatalf("UpdatePost() by co-author = %v, %v", updated, err)
+	}
+	if updated.Post.AuthorId != "alice" {
+		t.Errorf("UpdatePost() author = %s, want alice", updated.Post.AuthorId)
+	}
+
+	if _, err := server.DeletePost(bob, &proto.DeletePostRequest{PostId: postID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeletePost() by co-author error = %v, want PermissionDenied", err)
+	}
+	deleted, err := server.DeletePost(alice, &proto.DeletePostRequest{PostId: postID})
+	if err != nil || !deleted.Success {
+		t.Errorf("DeletePost() by author = %v, %v", deleted, err)
+	}
+}
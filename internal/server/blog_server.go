@@ -2,142 +2,361 @@ package server
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
 
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/idempotency"
 	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/tagging"
+	"github.com/kpauljoseph/test/internal/validation"
 	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
+// idempotencyKeyHeader is the metadata alternative to the idempotency_key
+// request field.
+const idempotencyKeyHeader = "idempotency-key"
+
+var tracer = otel.Tracer("github.com/kpauljoseph/test/internal/server")
+
 type BlogServer struct {
 	proto.UnimplementedBlogServiceServer
-	storage *storage.MemoryStorage
+	storage     *storage.MemoryStorage
+	policy      *authz.Policy
+	idempotency *idempotency.Store
+
+	reactionTypes []string
+	viewWindow    time.Duration
+	viewsOnRead   bool
+
+	duplicatePolicy DuplicatePolicy
+	tagNormalizer   *tagging.Normalizer
 }
 
-func NewBlogServer(storage *storage.MemoryStorage) *BlogServer {
-	return &BlogServer{
-		storage: storage,
+// Option configures optional BlogServer behaviour.
+type Option func(*BlogServer)
+
+// WithPolicy enables ownership checks so that only a post's author, or a
+// role allowed to modify any post, can update or delete it.
+func WithPolicy(p *authz.Policy) Option {
+	return func(s *BlogServer) {
+		s.policy = p
 	}
 }
 
-func (s *BlogServer) CreatePost(ctx context.Context, req *proto.CreatePostRequest) (*proto.CreatePostResponse, error) {
-	log.Printf("Creating post: title=%s, author=%s", req.Title, req.Author)
+// WithIdempotency makes CreatePost remember its results by idempotency key
+// so that retried requests do not create duplicate posts.
+func WithIdempotency(store *idempotency.Store) Option {
+	return func(s *BlogServer) {
+		s.idempotency = store
+	}
+}
 
-	if req.Title == "" {
-		return &proto.CreatePostResponse{
-			Error: "title is required",
-		}, nil
+// WithTagNormalizer sets how CreatePost and UpdatePost normalize tags.
+// tagging.DefaultConfig is used otherwise.
+func WithTagNormalizer(n *tagging.Normalizer) Option {
+	return func(s *BlogServer) {
+		s.tagNormalizer = n
 	}
-	if req.Content == "" {
-		return &proto.CreatePostResponse{
-			Error: "content is required",
-		}, nil
+}
+
+func NewBlogServer(storage *storage.MemoryStorage, opts ...Option) *BlogServer {
+	s := &BlogServer{
+		storage:       storage,
+		reactionTypes: DefaultReactionTypes,
+		viewWindow:    DefaultViewWindow,
+
+		duplicatePolicy: DuplicateWarn,
+		tagNormalizer:   tagging.NewNormalizer(tagging.DefaultConfig()),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// callerAuthor returns the author to record for a request. Authenticated
+// callers always write as themselves; the author field in the request is
+// only honoured when the server runs without authentication.
+func callerAuthor(ctx context.Context, requested string) string {
+	if id, ok := auth.FromContext(ctx); ok {
+		return id.Subject
+	}
+	return requested
+}
+
+// checkOwnership returns a PERMISSION_DENIED error when the caller may not
+// modify post as its author or one of its co-authors. It allows everything
+// when no policy or identity is present.
+func checkOwnership(ctx context.Context, policy *authz.Policy, post *proto.BlogPost) error {
+	for _, coAuthor := range post.CoAuthorIds {
+		if checkOwner(ctx, policy, "post", post.PostId, coAuthor) == nil {
+			return nil
+		}
+	}
+	return checkOwner(ctx, policy, "post", post.PostId, post.AuthorId)
+}
+
+// checkOwner returns a PERMISSION_DENIED error when the caller may not
+// modify a resource owned by owner under policy.
+func checkOwner(ctx context.Context, policy *authz.Policy, kind, resourceID, owner string) error {
+	id, ok := auth.FromContext(ctx)
+	if !ok || policy == nil {
+		return nil
+	}
+	if !policy.CanModify(id, owner) {
+		return status.Errorf(codes.PermissionDenied, "%s %s belongs to %s and cannot be modified by %s", kind, resourceID, owner, id.Subject)
 	}
-	if req.Author == "" {
+	return nil
+}
+
+func (s *BlogServer) CreatePost(ctx context.Context, req *proto.CreatePostRequest) (*proto.CreatePostResponse, error) {
+	ctx, span := tracer.Start(ctx, "BlogServer.CreatePost")
+	defer span.End()
+
+	author := callerAuthor(ctx, req.Author)
+	slog.InfoContext(ctx, "Creating post", "title", req.Title, "author", author)
+
+	if author == "" {
 		return &proto.CreatePostResponse{
 			Error: "author is required",
 		}, nil
 	}
-	if req.PublicationDate == nil {
+
+	// The whole response is remembered for idempotent retries so that a
+	// retry reports the same duplicate warnings and is not flagged as a
+	// duplicate of the post it created.
+	create := func() (protobuf.Message, error) {
+		if err := s.checkCategory(ctx, req.CategoryId); err != nil {
+			return nil, err
+		}
+		if err := s.checkCoAuthors(ctx, req.CoAuthorIds); err != nil {
+			return nil, err
+		}
+		// The category and co-authors are stored in the same write as
+		// the post so that its created event is complete, and near
+		// duplicates are looked for in it so that concurrent creates of
+		// the same content cannot both pass the check.
+		check := s.duplicateCheck()
+		post, err := s.storage.CreatePost(ctx, req.Title, req.Content, author, req.PublicationDate, s.tagNormalizer.Tags(req.Tags),
+			storage.WithCategory(req.CategoryId),
+			storage.WithCoAuthors(req.CoAuthorIds),
+			storage.WithDuplicateCheck(check),
+		)
+		warnings, err := duplicateWarnings(ctx, check, err)
+		if err != nil {
+			return nil, err
+		}
 		return &proto.CreatePostResponse{
-			Error: "publication_date is required",
+			Post:              protobuf.Clone(post).(*proto.BlogPost),
+			DuplicateWarnings: warnings,
 		}, nil
 	}
 
-	post, err := s.storage.CreatePost(req.Title, req.Content, req.Author, req.PublicationDate, req.Tags)
+	var result protobuf.Message
+	var replayed bool
+	var err error
+	if key := idempotencyKey(ctx, req); key != "" && s.idempotency != nil {
+		// The key is scoped to the author so that callers cannot observe
+		// each other's posts by guessing keys.
+		fingerprint := protobuf.Clone(req).(*proto.CreatePostRequest)
+		fingerprint.IdempotencyKey = ""
+		result, replayed, err = s.idempotency.Do(ctx, author+"\x00"+key, fingerprint, create)
+	} else {
+		result, err = create()
+	}
+	if errors.Is(err, idempotency.ErrKeyReused) {
+		slog.WarnContext(ctx, "Idempotency key reused", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if status.Code(err) == codes.AlreadyExists {
+		return nil, err
+	}
 	if err != nil {
-		log.Printf("Failed to create post: %v", err)
+		slog.ErrorContext(ctx, "Failed to create post", "error", err)
 		return &proto.CreatePostResponse{
 			Error: err.Error(),
 		}, nil
 	}
 
-	log.Printf("Post created successfully: postId=%s", post.PostId)
-	return &proto.CreatePostResponse{
-		Post: post,
-	}, nil
+	resp := protobuf.Clone(result).(*proto.CreatePostResponse)
+	resp.Replayed = replayed
+	if replayed {
+		slog.InfoContext(ctx, "Replayed post creation", "post_id", resp.Post.PostId)
+	} else {
+		slog.InfoContext(ctx, "Post created successfully", "post_id", resp.Post.PostId)
+	}
+	return resp, nil
+}
+
+// checkCategory fails when categoryID is set but does not name a category.
+// Categories are never deleted, so a category that exists here still
+// exists when the post is assigned to it.
+func (s *BlogServer) checkCategory(ctx context.Context, categoryID string) error {
+	if categoryID != "" && !s.storage.CategoryExists(ctx, categoryID) {
+		return fmt.Errorf("category with ID %s not found", categoryID)
+	}
+	return nil
+}
+
+// checkCoAuthors fails when one of authorIDs has no profile. Profiles are
+// never deleted, so the check still holds when the post is saved.
+func (s *BlogServer) checkCoAuthors(ctx context.Context, authorIDs []string) error {
+	for _, id := range authorIDs {
+		if _, err := s.storage.GetAuthor(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// idempotencyKey returns the key from the request field, falling back to
+// the idempotency-key metadata.
+func idempotencyKey(ctx context.Context, req *proto.CreatePostRequest) string {
+	if req.IdempotencyKey != "" {
+		return req.IdempotencyKey
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(idempotencyKeyHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
 }
 
 func (s *BlogServer) ReadPost(ctx context.Context, req *proto.ReadPostRequest) (*proto.ReadPostResponse, error) {
-	log.Printf("Reading post: postId=%s", req.PostId)
+	ctx, span := tracer.Start(ctx, "BlogServer.ReadPost")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Reading post", "post_id", req.PostId)
 
-	if req.PostId == "" {
+	if err := validation.Validate(req); err != nil {
 		return &proto.ReadPostResponse{
-			Error: "post_id is required",
+			Error: err.Error(),
 		}, nil
 	}
 
-	post, err := s.storage.GetPost(req.PostId)
+	post, err := s.storage.GetPost(ctx, req.PostId)
 	if err != nil {
-		log.Printf("Post not found: postId=%s, error=%v", req.PostId, err)
+		slog.WarnContext(ctx, "Post not found", "post_id", req.PostId, "error", err)
 		return &proto.ReadPostResponse{
 			Error: err.Error(),
 		}, nil
 	}
 
-	log.Printf("Post found: postId=%s, title=%s", post.PostId, post.Title)
+	slog.InfoContext(ctx, "Post found", "post_id", post.PostId, "title", post.Title)
+	s.recordReadView(ctx, post.PostId)
 	return &proto.ReadPostResponse{
-		Post: post,
+		Post:   post,
+		Series: s.storage.SeriesNavigation(ctx, post.PostId),
 	}, nil
 }
 
 func (s *BlogServer) UpdatePost(ctx context.Context, req *proto.UpdatePostRequest) (*proto.UpdatePostResponse, error) {
-	log.Printf("Updating post: postId=%s", req.PostId)
+	ctx, span := tracer.Start(ctx, "BlogServer.UpdatePost")
+	defer span.End()
 
-	if req.PostId == "" {
-		return &proto.UpdatePostResponse{
-			Error: "post_id is required",
-		}, nil
-	}
-	if req.Title == "" {
+	slog.InfoContext(ctx, "Updating post", "post_id", req.PostId)
+
+	// The primary author never changes so that a post keeps its author
+	// however the request's author field is set.
+	existing, err := s.storage.GetPost(ctx, req.PostId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to update post", "post_id", req.PostId, "error", err)
 		return &proto.UpdatePostResponse{
-			Error: "title is required",
+			Error: err.Error(),
 		}, nil
 	}
-	if req.Content == "" {
-		return &proto.UpdatePostResponse{
-			Error: "content is required",
-		}, nil
+	if err := checkOwnership(ctx, s.policy, existing); err != nil {
+		slog.WarnContext(ctx, "Update denied", "post_id", req.PostId, "error", err)
+		return nil, err
 	}
-	if req.Author == "" {
-		return &proto.UpdatePostResponse{
-			Error: "author is required",
-		}, nil
+
+	var opts []storage.PostOption
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "category_id":
+			if err := s.checkCategory(ctx, req.CategoryId); err != nil {
+				return &proto.UpdatePostResponse{
+					Error: err.Error(),
+				}, nil
+			}
+			opts = append(opts, storage.WithCategory(req.CategoryId))
+		case "co_author_ids":
+			if err := s.checkCoAuthors(ctx, req.CoAuthorIds); err != nil {
+				return &proto.UpdatePostResponse{
+					Error: err.Error(),
+				}, nil
+			}
+			opts = append(opts, storage.WithCoAuthors(req.CoAuthorIds))
+		default:
+			return &proto.UpdatePostResponse{
+				Error: fmt.Sprintf("update_mask: unsupported path %q, expected category_id or co_author_ids", path),
+			}, nil
+		}
 	}
 
-	post, err := s.storage.UpdatePost(req.PostId, req.Title, req.Content, req.Author, req.Tags)
+	post, err := s.storage.UpdatePost(ctx, req.PostId, req.Title, req.Content, existing.AuthorId, s.tagNormalizer.Tags(req.Tags), opts...)
 	if err != nil {
-		log.Printf("Failed to update post: postId=%s, error=%v", req.PostId, err)
+		slog.WarnContext(ctx, "Failed to update post", "post_id", req.PostId, "error", err)
 		return &proto.UpdatePostResponse{
 			Error: err.Error(),
 		}, nil
 	}
 
-	log.Printf("Post updated successfully: postId=%s", post.PostId)
+	slog.InfoContext(ctx, "Post updated successfully", "post_id", post.PostId)
 	return &proto.UpdatePostResponse{
 		Post: post,
 	}, nil
 }
 
 func (s *BlogServer) DeletePost(ctx context.Context, req *proto.DeletePostRequest) (*proto.DeletePostResponse, error) {
-	log.Printf("Deleting post: postId=%s", req.PostId)
+	ctx, span := tracer.Start(ctx, "BlogServer.DeletePost")
+	defer span.End()
 
-	if req.PostId == "" {
+	slog.InfoContext(ctx, "Deleting post", "post_id", req.PostId)
+
+	if err := validation.Validate(req); err != nil {
 		return &proto.DeletePostResponse{
 			Success: false,
-			Error:   "post_id is required",
+			Error:   err.Error(),
 		}, nil
 	}
 
-	err := s.storage.DeletePost(req.PostId)
+	if _, ok := auth.FromContext(ctx); ok {
+		existing, err := s.storage.GetPost(ctx, req.PostId)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to delete post", "post_id", req.PostId, "error", err)
+			return &proto.DeletePostResponse{
+				Success: false,
+				Error:   err.Error(),
+			}, nil
+		}
+		// Co-authors may edit a post but only its primary author may
+		// delete it.
+		if err := checkOwner(ctx, s.policy, "post", existing.PostId, existing.AuthorId); err != nil {
+			slog.WarnContext(ctx, "Delete denied", "post_id", req.PostId, "error", err)
+			return nil, err
+		}
+	}
+
+	err := s.storage.DeletePost(ctx, req.PostId)
 	if err != nil {
-		log.Printf("Failed to delete post: postId=%s, error=%v", req.PostId, err)
+		slog.WarnContext(ctx, "Failed to delete post", "post_id", req.PostId, "error", err)
 		return &proto.DeletePostResponse{
 			Success: false,
 			Error:   err.Error(),
 		}, nil
 	}
 
-	log.Printf("Post deleted successfully: postId=%s", req.PostId)
+	slog.InfoContext(ctx, "Post deleted successfully", "post_id", req.PostId)
 	return &proto.DeletePostResponse{
 		Success: true,
 	}, nil
@@ -5,8 +5,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/idempotency"
 	"github.com/kpauljoseph/test/internal/storage"
 	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -31,26 +38,6 @@ func TestBlogServer_CreatePost(t *testing.T) {
 			},
 			wantErr: false,
 		},
-		{
-			name: "missing title",
-			req: &proto.CreatePostRequest{
-				Content:         "This is test content",
-				Author:          "Test Author",
-				PublicationDate: timestamppb.New(time.Now()),
-				Tags:            []string{"test"},
-			},
-			wantErr: true,
-		},
-		{
-			name: "missing content",
-			req: &proto.CreatePostRequest{
-				Title:           "Test Post",
-				Author:          "Test Author",
-				PublicationDate: timestamppb.New(time.Now()),
-				Tags:            []string{"test"},
-			},
-			wantErr: true,
-		},
 		{
 			name: "missing author",
 			req: &proto.CreatePostRequest{
@@ -61,16 +48,6 @@ func TestBlogServer_CreatePost(t *testing.T) {
 			},
 			wantErr: true,
 		},
-		{
-			name: "missing publication date",
-			req: &proto.CreatePostRequest{
-				Title:   "Test Post",
-				Content: "This is test content",
-				Author:  "Test Author",
-				Tags:    []string{"test"},
-			},
-			wantErr: true,
-		},
 		{
 			name: "valid post without tags",
 			req: &proto.CreatePostRequest{
@@ -125,7 +102,7 @@ func TestBlogServer_ReadPost(t *testing.T) {
 	server := NewBlogServer(memoryStorage)
 	ctx := context.Background()
 
-	post, err := memoryStorage.CreatePost("Test Post", "Test Content", "Test Author", timestamppb.New(time.Now()), []string{"test"})
+	post, err := memoryStorage.CreatePost(ctx, "Test Post", "Test Content", "Test Author", timestamppb.New(time.Now()), []string{"test"})
 	if err != nil {
 		t.Fatalf("Failed to create test post: %v", err)
 	}
@@ -194,7 +171,7 @@ func TestBlogServer_UpdatePost(t *testing.T) {
 	server := NewBlogServer(memoryStorage)
 	ctx := context.Background()
 
-	post, err := memoryStorage.CreatePost("Original Title", "Original Content", "Original Author", timestamppb.New(time.Now()), []string{"original"})
+	post, err := memoryStorage.CreatePost(ctx, "Original Title", "Original Content", "Original Author", timestamppb.New(time.Now()), []string{"original"})
 	if err != nil {
 		t.Fatalf("Failed to create test post: %v", err)
 	}
@@ -237,31 +214,13 @@ func TestBlogServer_UpdatePost(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "missing title",
-			req: &proto.UpdatePostRequest{
-				PostId:  post.PostId,
-				Content: "Content",
-				Author:  "Author",
-			},
-			wantErr: true,
-		},
-		{
-			name: "missing content",
-			req: &proto.UpdatePostRequest{
-				PostId: post.PostId,
-				Title:  "Title",
-				Author: "Author",
-			},
-			wantErr: true,
-		},
-		{
-			name: "missing author",
+			name: "author is not required",
 			req: &proto.UpdatePostRequest{
 				PostId:  post.PostId,
 				Title:   "Title",
 				Content: "Content",
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 
@@ -290,8 +249,10 @@ func TestBlogServer_UpdatePost(t *testing.T) {
 					if resp.Post.Title != tt.req.Title {
 						t.Errorf("UpdatePost() title = %v, want %v", resp.Post.Title, tt.req.Title)
 					}
-					if resp.Post.Author != tt.req.Author {
-						t.Errorf("UpdatePost() author = %v, want %v", resp.Post.Author, tt.req.Author)
+					// The request's author is ignored; posts keep their
+					// primary author.
+					if resp.Post.Author != post.Author || resp.Post.AuthorId != post.AuthorId {
+						t.Errorf("UpdatePost() author = %v (%v), want %v", resp.Post.Author, resp.Post.AuthorId, post.Author)
 					}
 				}
 			}
@@ -304,7 +265,7 @@ func TestBlogServer_DeletePost(t *testing.T) {
 	server := NewBlogServer(memoryStorage)
 	ctx := context.Background()
 
-	post, err := memoryStorage.CreatePost("Test Post", "Test Content", "Test Author", timestamppb.New(time.Now()), []string{"test"})
+	post, err := memoryStorage.CreatePost(ctx, "Test Post", "Test Content", "Test Author", timestamppb.New(time.Now()), []string{"test"})
 	if err != nil {
 		t.Fatalf("Failed to create test post: %v", err)
 	}
@@ -368,6 +329,159 @@ func TestBlogServer_DeletePost(t *testing.T) {
 	}
 }
 
+func TestBlogServer_AuthenticatedAuthor(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage)
+	ctx := auth.NewContext(context.Background(), &auth.Identity{Subject: "user-1"})
+
+	createResp, err := server.CreatePost(ctx, &proto.CreatePostRequest{
+		Title:           "Test Post",
+		Content:         "This is test content",
+		Author:          "Someone Else",
+		PublicationDate: timestamppb.New(time.Now()),
+	})
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+	if createResp.Error != "" {
+		t.Fatalf("CreatePost() error = %s", createResp.Error)
+	}
+	if createResp.Post.Author != "user-1" {
+		t.Errorf("CreatePost() author = %v, want user-1", createResp.Post.Author)
+	}
+
+	withoutAuthor, err := server.CreatePost(ctx, &proto.CreatePostRequest{
+		Title:           "Test Post",
+		Content:         "This is test content",
+		PublicationDate: timestamppb.New(time.Now()),
+	})
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+	if withoutAuthor.Error != "" {
+		t.Errorf("CreatePost() should not require author for authenticated callers: %s", withoutAuthor.Error)
+	}
+
+	updateResp, err := server.UpdatePost(ctx, &proto.UpdatePostRequest{
+		PostId:  createResp.Post.PostId,
+		Title:   "Updated Title",
+		Content: "Updated content",
+		Author:  "Someone Else",
+	})
+	if err != nil {
+		t.Fatalf("UpdatePost() error = %v", err)
+	}
+	if updateResp.Error != "" {
+		t.Fatalf("UpdatePost() error = %s", updateResp.Error)
+	}
+	if updateResp.Post.Author != "user-1" {
+		t.Errorf("UpdatePost() author = %v, want user-1", updateResp.Post.Author)
+	}
+}
+
+func TestBlogServer_Ownership(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage, WithPolicy(authz.DefaultPolicy()))
+
+	alice := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice", Roles: []string{"author"}})
+	bob := auth.NewContext(context.Background(), &auth.Identity{Subject: "bob", Roles: []string{"author"}})
+	editor := auth.NewContext(context.Background(), &auth.Identity{Subject: "carol", Roles: []string{"editor"}})
+
+	createResp, err := server.CreatePost(alice, &proto.CreatePostRequest{
+		Title:           "Alice's Post",
+		Content:         "Content",
+		PublicationDate: timestamppb.New(time.Now()),
+	})
+	if err != nil || createResp.Error != "" {
+		t.Fatalf("CreatePost() error = %v, %s", err, createResp.GetError())
+	}
+	postID := createResp.Post.PostId
+
+	update := &proto.UpdatePostRequest{PostId: postID, Title: "Changed", Content: "Changed"}
+
+	if _, err := server.UpdatePost(bob, update); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdatePost() by non-owner code = %v, want PermissionDenied", status.Code(err))
+	}
+	if _, err := server.DeletePost(bob, &proto.DeletePostRequest{PostId: postID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeletePost() by non-owner code = %v, want PermissionDenied", status.Code(err))
+	}
+
+	resp, err := server.UpdatePost(editor, update)
+	if err != nil || resp.Error != "" {
+		t.Fatalf("UpdatePost() by editor error = %v, %s", err, resp.GetError())
+	}
+	if resp.Post.Author != "alice" {
+		t.Errorf("UpdatePost() by editor author = %v, want alice", resp.Post.Author)
+	}
+
+	deleteResp, err := server.DeletePost(alice, &proto.DeletePostRequest{PostId: postID})
+	if err != nil || !deleteResp.Success {
+		t.Errorf("DeletePost() by owner error = %v, %s", err, deleteResp.GetError())
+	}
+}
+
+func TestBlogServer_IdempotentCreate(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage, WithIdempotency(idempotency.NewStore(time.Hour)))
+	ctx := context.Background()
+	pubDate := timestamppb.New(time.Now())
+
+	req := &proto.CreatePostRequest{
+		Title:           "Test Post",
+		Content:         "This is test content",
+		Author:          "Test Author",
+		PublicationDate: pubDate,
+		IdempotencyKey:  "retry-1",
+	}
+
+	first, err := server.CreatePost(ctx, req)
+	if err != nil || first.Error != "" {
+		t.Fatalf("CreatePost() error = %v, %s", err, first.GetError())
+	}
+	if first.Replayed {
+		t.Error("CreatePost() first call should not be a replay")
+	}
+
+	retry, err := server.CreatePost(ctx, req)
+	if err != nil || retry.Error != "" {
+		t.Fatalf("CreatePost() retry error = %v, %s", err, retry.GetError())
+	}
+	if !retry.Replayed || retry.Post.PostId != first.Post.PostId {
+		t.Errorf("CreatePost() retry = %v (replayed %v), want post %v", retry.Post.PostId, retry.Replayed, first.Post.PostId)
+	}
+
+	changed := &proto.CreatePostRequest{
+		Title:           "Different Title",
+		Content:         "This is test content",
+		Author:          "Test Author",
+		PublicationDate: pubDate,
+		IdempotencyKey:  "retry-1",
+	}
+	if _, err := server.CreatePost(ctx, changed); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreatePost() with reused key code = %v, want FailedPrecondition", status.Code(err))
+	}
+
+	mdCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("idempotency-key", "retry-2"))
+	viaMetadata := &proto.CreatePostRequest{
+		Title:           "Metadata Post",
+		Content:         "This is test content",
+		Author:          "Test Author",
+		PublicationDate: pubDate,
+	}
+	a, err := server.CreatePost(mdCtx, viaMetadata)
+	if err != nil || a.Error != "" {
+		t.Fatalf("CreatePost() error = %v, %s", err, a.GetError())
+	}
+	b, err := server.CreatePost(mdCtx, viaMetadata)
+	if err != nil || !b.Replayed || b.Post.PostId != a.Post.PostId {
+		t.Errorf("CreatePost() metadata retry should replay %v, got %v (err %v)", a.Post.PostId, b.GetPost().GetPostId(), err)
+	}
+
+	if stats := memoryStorage.Stats(ctx); stats.Posts != 2 {
+		t.Errorf("storage has %d posts, want 2", stats.Posts)
+	}
+}
+
 func TestBlogServer_Integration(t *testing.T) {
 	memoryStorage := storage.NewMemoryStorage()
 	server := NewBlogServer(memoryStorage)
@@ -448,3 +562,63 @@ func TestBlogServer_Integration(t *testing.T) {
 		}
 	})
 }
+
+func TestBlogServer_UpdatePostMask(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage)
+	ctx := context.Background()
+
+	category, err := memoryStorage.CreateCategory(ctx, "Go", "")
+	if err != nil {
+		t.Fatalf("CreateCategory() error = %v", err)
+	}
+	if _, err := memoryStorage.CreateAuthor(ctx, &proto.Author{AuthorId: "bob", DisplayName: "Bob"}); err != nil {
+		t.Fatalf("CreateAuthor() error = %v", err)
+	}
+	created, err := server.CreatePost(ctx, &proto.CreatePostRequest{
+		Title:           "Post",
+		Content:         "Content",
+		Author:          "alice",
+		PublicationDate: timestamppb.New(time.Now()),
+		CategoryId:      category.CategoryId,
+		CoAuthorIds:     []string{"bob"},
+	})
+	if err != nil || created.Error != "" {
+		t.Fatalf("CreatePost() = %v, %v", created, err)
+	}
+
+	tests := []struct {
+		name          string
+		mask          []string
+		wantError     bool
+		wantCategory  string
+		wantCoAuthors int
+	}{
+		{name: "no mask keeps both", wantCategory: category.CategoryId, wantCoAuthors: 1},
+		{name: "co-authors only", mask: []string{"co_author_ids"}, wantCategory: category.CategoryId, wantCoAuthors: 0},
+		{name: "category", mask: []string{"category_id"}, wantCategory: "", wantCoAuthors: 0},
+		{name: "unknown path", mask: []string{"title"}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &proto.UpdatePostRequest{PostId: created.Post.PostId, Title: "Post", Content: "Content"}
+			if tt.mask != nil {
+				req.UpdateMask = &fieldmaskpb.FieldMask{Paths: tt.mask}
+			}
+			resp, err := server.UpdatePost(ctx, req)
+			if err != nil {
+				t.Fatalf("UpdatePost() error = %v", err)
+			}
+			if (resp.Error != "") != tt.wantError {
+				t.Fatalf("UpdatePost() error = %q, wantError %v", resp.Error, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if resp.Post.CategoryId != tt.wantCategory || len(resp.Post.CoAuthorIds) != tt.wantCoAuthors {
+				t.Errorf("UpdatePost() category = %q, co-authors = %v, want %q and %d co-authors", resp.Post.CategoryId, resp.Post.CoAuthorIds, tt.wantCategory, tt.wantCoAuthors)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+type CategoryServer struct {
+	proto.UnimplementedCategoryServiceServer
+	storage *storage.MemoryStorage
+}
+
+func NewCategoryServer(storage *storage.MemoryStorage) *CategoryServer {
+	return &CategoryServer{
+		storage: storage,
+	}
+}
+
+func (s *CategoryServer) CreateCategory(ctx context.Context, req *proto.CreateCategoryRequest) (*proto.CreateCategoryResponse, error) {
+	ctx, span := tracer.Start(ctx, "CategoryServer.CreateCategory")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Creating category", "name", req.Name, "parent_id", req.ParentId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.CreateCategoryResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	category, err := s.storage.CreateCategory(ctx, req.Name, req.ParentId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to create category", "name", req.Name, "error", err)
+		return &proto.CreateCategoryResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Category created successfully", "category_id", category.CategoryId)
+	return &proto.CreateCategoryResponse{
+		Category: category,
+	}, nil
+}
+
+func (s *CategoryServer) MoveCategory(ctx context.Context, req *proto.MoveCategoryRequest) (*proto.MoveCategoryResponse, error) {
+	ctx, span := tracer.Start(ctx, "CategoryServer.MoveCategory")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Moving category", "category_id", req.CategoryId, "new_parent_id", req.NewParentId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.MoveCategoryResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	category, err := s.storage.MoveCategory(ctx, req.CategoryId, req.NewParentId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to move category", "category_id", req.CategoryId, "error", err)
+		return &proto.MoveCategoryResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Category moved successfully", "category_id", category.CategoryId)
+	return &proto.MoveCategoryResponse{
+		Category: category,
+	}, nil
+}
+
+func (s *CategoryServer) ListCategories(ctx context.Context, req *proto.ListCategoriesRequest) (*proto.ListCategoriesResponse, error) {
+	ctx, span := tracer.Start(ctx, "CategoryServer.ListCategories")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing categories")
+
+	return &proto.ListCategoriesResponse{
+		Categories: s.storage.ListCategories(ctx),
+	}, nil
+}
+
+func (s *CategoryServer) ListCategoryPosts(ctx context.Context, req *proto.ListCategoryPostsRequest) (*proto.ListCategoryPostsResponse, error) {
+	ctx, span := tracer.Start(ctx, "CategoryServer.ListCategoryPosts")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing category posts", "category_id", req.CategoryId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ListCategoryPostsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return &proto.ListCategoryPostsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	posts, err := s.storage.ListCategoryPosts(ctx, req.CategoryId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list category posts", "category_id", req.CategoryId, "error", err)
+		return &proto.ListCategoryPostsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	page, next := paginate(posts, offset, int(req.PageSize))
+	return &proto.ListCategoryPostsResponse{
+		Posts:         page,
+		NextPageToken: next,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCategoryServer(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	blogServer := NewBlogServer(memoryStorage)
+	categoryServer := NewCategoryServer(memoryStorage)
+	ctx := context.Background()
+
+	tech, err := categoryServer.CreateCategory(ctx, &proto.CreateCategoryRequest{Name: "Tech"})
+	if err != nil || tech.Error != "" {
+		t.Fatalf("CreateCategory() = %v, %v", tech, err)
+	}
+	golang, err := categoryServer.CreateCategory(ctx, &proto.CreateCategoryRequest{Name: "Go", ParentId: tech.Category.CategoryId})
+	if err != nil || golang.Error != "" {
+		t.Fatalf("CreateCategory() = %v, %v", golang, err)
+	}
+	invalid, err := categoryServer.CreateCategory(ctx, &proto.CreateCategoryRequest{})
+	if err != nil || invalid.Error == "" {
+		t.Errorf("CreateCategory() without name = %v, %v, want error in response", invalid, err)
+	}
+
+	now := time.Now()
+	var postIDs []string
+	for i, category := range []string{tech.Category.CategoryId, golang.Category.CategoryId, golang.Category.CategoryId} {
+		resp, err := blogServer.CreatePost(ctx, &proto.CreatePostRequest{
+			Title:           "Post",
+			Content:         "Content",
+			Author:          "Author",
+			PublicationDate: timestamppb.New(now.Add(time.Duration(i) * time.Minute)),
+			CategoryId:      category,
+		})
+		if err != nil || resp.Error != "" {
+			t.Fatalf("CreatePost() = %v, %v", resp, err)
+		}
+		if resp.Post.CategoryId != category {
+			t.Errorf("CreatePost() category = %s, want %s", resp.Post.CategoryId, category)
+		}
+		postIDs = append(postIDs, resp.Post.PostId)
+	}
+
+	missing, err := blogServer.CreatePost(ctx, &proto.CreatePostRequest{
+		Title:           "Post",
+		Content:         "Content",
+		Author:          "Author",
+		PublicationDate: timestamppb.New(now),
+		CategoryId:      "missing",
+	})
+	if err != nil || missing.Error == "" {
+		t.Errorf("CreatePost() with missing category = %v, %v, want error in response", missing, err)
+	}
+
+	var got []string
+	var pageToken string
+	for {
+		resp, err := categoryServer.ListCategoryPosts(ctx, &proto.ListCategoryPostsRequest{
+			CategoryId: tech.Category.CategoryId,
+			PageSize:   2,
+			PageToken:  pageToken,
+		})
+		if err != nil || resp.Error != "" {
+			t.Fatalf("ListCategoryPosts() = %v, %v", resp, err)
+		}
+		for _, p := range resp.Posts {
+			got = append(got, p.PostId)
+		}
+		if pageToken = resp.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+	if want := []string{postIDs[2], postIDs[1], postIDs[0]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListCategoryPosts() = %v, want %v", got, want)
+	}
+
+	kept, err := blogServer.UpdatePost(ctx, &proto.UpdatePostRequest{
+		PostId:  postIDs[0],
+		Title:   "Post",
+		Content: "Content",
+		Author:  "Author",
+	})
+	if err != nil || kept.Error != "" || kept.Post.CategoryId == "" {
+		t.Errorf("UpdatePost() without update mask = %v, %v, want category kept", kept, err)
+	}
+
+	update, err := blogServer.UpdatePost(ctx, &proto.UpdatePostRequest{
+		PostId:     postIDs[0],
+		Title:      "Post",
+		Content:    "Content",
+		Author:     "Author",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"category_id"}},
+	})
+	if err != nil || update.Error != "" || update.Post.CategoryId != "" {
+		t.Errorf("UpdatePost() with empty category in update mask = %v, %v, want category removed", update, err)
+	}
+
+	life, _ := categoryServer.CreateCategory(ctx, &proto.CreateCategoryRequest{Name: "Life"})
+	moved, err := categoryServer.MoveCategory(ctx, &proto.MoveCategoryRequest{
+		CategoryId:  golang.Category.CategoryId,
+		NewParentId: life.Category.CategoryId,
+	})
+	if err != nil || moved.Error != "" {
+		t.Fatalf("MoveCategory() = %v, %v", moved, err)
+	}
+
+	list, err := categoryServer.ListCategories(ctx, &proto.ListCategoriesRequest{})
+	if err != nil {
+		t.Fatalf("ListCategories() error = %v", err)
+	}
+	counts := make(map[string]int32)
+	for _, c := range list.Categories {
+		counts[c.Name] = c.PostCount
+	}
+	if want := map[string]int32{"Life": 2, "Go": 2, "Tech": 0}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("ListCategories() post counts = %v, want %v", counts, want)
+	}
+
+	cycle, err := categoryServer.MoveCategory(ctx, &proto.MoveCategoryRequest{
+		CategoryId:  life.Category.CategoryId,
+		NewParentId: golang.Category.CategoryId,
+	})
+	if err != nil || cycle.Error == "" {
+		t.Errorf("MoveCategory() below descendant = %v, %v, want error in response", cycle, err)
+	}
+}
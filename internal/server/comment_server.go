@@ -0,0 +1,346 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/moderation"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type CommentServer struct {
+	proto.UnimplementedCommentServiceServer
+	storage   *storage.MemoryStorage
+	policy    *authz.Policy
+	moderator *moderation.Moderator
+}
+
+// NewCommentServer creates a CommentServer. When policy is non-nil, only a
+// comment's author or a role allowed to modify anything may edit or delete
+// it. New and edited comments are checked by moderator; a nil moderator
+// approves everything.
+func NewCommentServer(storage *storage.MemoryStorage, policy *authz.Policy, moderator *moderation.Moderator) *CommentServer {
+	return &CommentServer{
+		storage:   storage,
+		policy:    policy,
+		moderator: moderator,
+	}
+}
+
+func (s *CommentServer) AddComment(ctx context.Context, req *proto.AddCommentRequest) (*proto.AddCommentResponse, error) {
+	ctx, span := tracer.Start(ctx, "CommentServer.AddComment")
+	defer span.End()
+
+	author := callerAuthor(ctx, req.Author)
+	slog.InfoContext(ctx, "Adding comment", "post_id", req.PostId, "parent_id", req.ParentId, "author", author)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.AddCommentResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if author == "" {
+		return &proto.AddCommentResponse{
+			Error: "author is required",
+		}, nil
+	}
+
+	decision := moderation.Decision{State: proto.ModerationState_MODERATION_STATE_APPROVED}
+	if s.moderator != nil {
+		decision = s.moderator.Evaluate(req.Content, s.storage.CountApprovedComments(ctx, author))
+	}
+
+	comment, err := s.storage.AddComment(ctx, req.PostId, req.ParentId, author, req.Content, decision.State, decision.Reason)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to add comment", "post_id", req.PostId, "error", err)
+		return &proto.AddCommentResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Comment added successfully", "comment_id", comment.CommentId, "moderation_state", comment.ModerationState)
+	return &proto.AddCommentResponse{
+		Comment: comment,
+	}, nil
+}
+
+func (s *CommentServer) ListComments(ctx context.Context, req *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error) {
+	ctx, span := tracer.Start(ctx, "CommentServer.ListComments")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing comments", "post_id", req.PostId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ListCommentsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return &proto.ListCommentsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	comments, err := s.storage.ListComments(ctx, req.PostId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list comments", "post_id", req.PostId, "error", err)
+		return &proto.ListCommentsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	threads, next := paginate(buildThreads(comments), offset, int(req.PageSize))
+	return &proto.ListCommentsResponse{
+		Comments:      threads,
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *CommentServer) EditComment(ctx context.Context, req *proto.EditCommentRequest) (*proto.EditCommentResponse, error) {
+	ctx, span := tracer.Start(ctx, "CommentServer.EditComment")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Editing comment", "comment_id", req.CommentId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.EditCommentResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	existing, err := s.storage.GetComment(ctx, req.CommentId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to edit comment", "comment_id", req.CommentId, "error", err)
+		return &proto.EditCommentResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := checkOwner(ctx, s.policy, "comment", existing.CommentId, existing.Author); err != nil {
+		slog.WarnContext(ctx, "Edit denied", "comment_id", req.CommentId, "error", err)
+		return nil, err
+	}
+
+	// Edits keep the comment's current state unless the new content breaks
+	// a rule, so an approved comment cannot be edited into spam.
+	var decision moderation.Decision
+	if s.moderator != nil {
+		if d := s.moderator.CheckContent(req.Content); d.State != proto.ModerationState_MODERATION_STATE_APPROVED {
+			decision = d
+		}
+	}
+
+	comment, err := s.storage.UpdateComment(ctx, req.CommentId, req.Content, decision.State, decision.Reason)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to edit comment", "comment_id", req.CommentId, "error", err)
+		return &proto.EditCommentResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Comment edited successfully", "comment_id", comment.CommentId)
+	return &proto.EditCommentResponse{
+		Comment: comment,
+	}, nil
+}
+
+func (s *CommentServer) DeleteComment(ctx context.Context, req *proto.DeleteCommentRequest) (*proto.DeleteCommentResponse, error) {
+	ctx, span := tracer.Start(ctx, "CommentServer.DeleteComment")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Deleting comment", "comment_id", req.CommentId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.DeleteCommentResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	existing, err := s.storage.GetComment(ctx, req.CommentId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to delete comment", "comment_id", req.CommentId, "error", err)
+		return &proto.DeleteCommentResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	if err := checkOwner(ctx, s.policy, "comment", existing.CommentId, existing.Author); err != nil {
+		slog.WarnContext(ctx, "Delete denied", "comment_id", req.CommentId, "error", err)
+		return nil, err
+	}
+
+	if err := s.storage.DeleteComment(ctx, req.CommentId); err != nil {
+		slog.WarnContext(ctx, "Failed to delete comment", "comment_id", req.CommentId, "error", err)
+		return &proto.DeleteCommentResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Comment deleted successfully", "comment_id", req.CommentId)
+	return &proto.DeleteCommentResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *CommentServer) ListModerationQueue(ctx context.Context, req *proto.ListModerationQueueRequest) (*proto.ListModerationQueueResponse, error) {
+	ctx, span := tracer.Start(ctx, "CommentServer.ListModerationQueue")
+	defer span.End()
+
+	state := req.State
+	if state == proto.ModerationState_MODERATION_STATE_UNSPECIFIED {
+		state = proto.ModerationState_MODERATION_STATE_PENDING
+	}
+	slog.InfoContext(ctx, "Listing moderation queue", "state", state)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ListModerationQueueResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return &proto.ListModerationQueueResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	comments, err := s.storage.ListCommentsByState(ctx, state)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list moderation queue", "state", state, "error", err)
+		return &proto.ListModerationQueueResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	page, next := paginate(comments, offset, int(req.PageSize))
+	return &proto.ListModerationQueueResponse{
+		Comments:      page,
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *CommentServer) ModerateComment(ctx context.Context, req *proto.ModerateCommentRequest) (*proto.ModerateCommentResponse, error) {
+	ctx, span := tracer.Start(ctx, "CommentServer.ModerateComment")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Moderating comment", "comment_id", req.CommentId, "state", req.State)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ModerateCommentResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if req.State == proto.ModerationState_MODERATION_STATE_UNSPECIFIED {
+		return &proto.ModerateCommentResponse{
+			Error: "state is required",
+		}, nil
+	}
+
+	var moderator string
+	if id, ok := auth.FromContext(ctx); ok {
+		moderator = id.Subject
+	}
+
+	comment, err := s.storage.ModerateComment(ctx, req.CommentId, req.State, req.Reason, moderator)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to moderate comment", "comment_id", req.CommentId, "error", err)
+		return &proto.ModerateCommentResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Comment moderated successfully", "comment_id", comment.CommentId, "state", comment.ModerationState)
+	return &proto.ModerateCommentResponse{
+		Comment: comment,
+	}, nil
+}
+
+// buildThreads nests replies under their parents and returns the top-level
+// comments in their original order. Deleted and unapproved comments are
+// only kept, without their content, when they still have visible replies.
+func buildThreads(comments []*proto.Comment) []*proto.Comment {
+	byID := make(map[string]*proto.Comment, len(comments))
+	for _, c := range comments {
+		byID[c.CommentId] = c
+	}
+
+	var roots []*proto.Comment
+	for _, c := range comments {
+		if parent, ok := byID[c.ParentId]; ok {
+			parent.Replies = append(parent.Replies, c)
+		} else {
+			roots = append(roots, c)
+		}
+	}
+	return pruneHidden(roots)
+}
+
+func pruneHidden(comments []*proto.Comment) []*proto.Comment {
+	visible := comments[:0]
+	for _, c := range comments {
+		c.Replies = pruneHidden(c.Replies)
+		if c.Deleted || c.ModerationState != proto.ModerationState_MODERATION_STATE_APPROVED {
+			if len(c.Replies) == 0 {
+				continue
+			}
+			c.Content = ""
+			c.ModerationReason = ""
+		}
+		visible = append(visible, c)
+	}
+	return visible
+}
+
+// paginate returns up to pageSize items starting at offset and the token
+// of the following page, which is empty on the last page.
+func paginate[T any](items []T, offset, pageSize int) ([]T, string) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := offset + pageSize
+	if end >= len(items) {
+		return items[offset:], ""
+	}
+	return items[offset:end], encodePageToken(end)
+}
+
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid page_token")
+	}
+	offset, err := strconv.Atoi(string(data))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid page_token")
+	}
+	return offset, nil
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/moderation"
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newCommentTestPost(t *testing.T, memoryStorage *storage.MemoryStorage) string {
+	t.Helper()
+	post, err := memoryStorage.CreatePost(context.Background(), "Post", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+	return post.PostId
+}
+
+func TestCommentServer_AddComment(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewCommentServer(memoryStorage, nil, nil)
+	ctx := context.Background()
+	postID := newCommentTestPost(t, memoryStorage)
+
+	tests := []struct {
+		name    string
+		req     *proto.AddCommentRequest
+		wantErr bool
+	}{
+		{
+			name:    "valid comment",
+			req:     &proto.AddCommentRequest{PostId: postID, Author: "alice", Content: "Nice post"},
+			wantErr: false,
+		},
+		{
+			name:    "missing content",
+			req:     &proto.AddCommentRequest{PostId: postID, Author: "alice"},
+			wantErr: true,
+		},
+		{
+			name:    "missing author",
+			req:     &proto.AddCommentRequest{PostId: postID, Content: "Nice post"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown post",
+			req:     &proto.AddCommentRequest{PostId: "missing", Author: "alice", Content: "Nice post"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.AddComment(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("AddComment() unexpected error = %v", err)
+			}
+			if tt.wantErr && resp.Error == "" {
+				t.Error("AddComment() expected error in response")
+			}
+			if !tt.wantErr && resp.Error != "" {
+				t.Errorf("AddComment() unexpected error in response = %v", resp.Error)
+			}
+		})
+	}
+}
+
+func TestCommentServer_ListCommentsThreads(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewCommentServer(memoryStorage, nil, nil)
+	ctx := context.Background()
+	postID := newCommentTestPost(t, memoryStorage)
+
+	add := func(parentID, content string) string {
+		resp, err := server.AddComment(ctx, &proto.AddCommentRequest{PostId: postID, ParentId: parentID, Author: "alice", Content: content})
+		if err != nil || resp.Error != "" {
+			t.Fatalf("AddComment() error = %v, %s", err, resp.GetError())
+		}
+		return resp.Comment.CommentId
+	}
+
+	first := add("", "first")
+	add(first, "reply")
+	second := add("", "second")
+	third := add("", "third")
+
+	if _, err := server.DeleteComment(ctx, &proto.DeleteCommentRequest{CommentId: first}); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+	if _, err := server.DeleteComment(ctx, &proto.DeleteCommentRequest{CommentId: second}); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+
+	page, err := server.ListComments(ctx, &proto.ListCommentsRequest{PostId: postID, PageSize: 1})
+	if err != nil || page.Error != "" {
+		t.Fatalf("ListComments() error = %v, %s", err, page.GetError())
+	}
+	if len(page.Comments) != 1 || page.Comments[0].CommentId != first {
+		t.Fatalf("ListComments() first page = %v, want deleted placeholder %s", page.Comments, first)
+	}
+	if !page.Comments[0].Deleted || len(page.Comments[0].Replies) != 1 {
+		t.Errorf("ListComments() placeholder = %+v, want deleted with one reply", page.Comments[0])
+	}
+	if page.NextPageToken == "" {
+		t.Fatal("ListComments() expected next page token")
+	}
+
+	page, err = server.ListComments(ctx, &proto.ListCommentsRequest{PostId: postID, PageSize: 1, PageToken: page.NextPageToken})
+	if err != nil || page.Error != "" {
+		t.Fatalf("ListComments() error = %v, %s", err, page.GetError())
+	}
+	if len(page.Comments) != 1 || page.Comments[0].CommentId != third {
+		t.Errorf("ListComments() second page = %v, want %s", page.Comments, third)
+	}
+	if page.NextPageToken != "" {
+		t.Errorf("ListComments() next page token = %q, want empty", page.NextPageToken)
+	}
+
+	bad, err := server.ListComments(ctx, &proto.ListCommentsRequest{PostId: postID, PageToken: "!!"})
+	if err != nil || bad.Error == "" {
+		t.Errorf("ListComments() with invalid token = %v, %q, want error in response", err, bad.GetError())
+	}
+}
+
+func TestCommentServer_Ownership(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewCommentServer(memoryStorage, authz.DefaultPolicy(), nil)
+	postID := newCommentTestPost(t, memoryStorage)
+
+	alice := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice"})
+	bob := auth.NewContext(context.Background(), &auth.Identity{Subject: "bob"})
+	editor := auth.NewContext(context.Background(), &auth.Identity{Subject: "carol", Roles: []string{"editor"}})
+
+	addResp, err := server.AddComment(alice, &proto.AddCommentRequest{PostId: postID, Content: "Hello"})
+	if err != nil || addResp.Error != "" {
+		t.Fatalf("AddComment() error = %v, %s", err, addResp.GetError())
+	}
+	if addResp.Comment.Author != "alice" {
+		t.Errorf("AddComment() author = %v, want alice", addResp.Comment.Author)
+	}
+	commentID := addResp.Comment.CommentId
+
+	if _, err := server.EditComment(bob, &proto.EditCommentRequest{CommentId: commentID, Content: "Hijacked"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("EditComment() by non-owner code = %v, want PermissionDenied", status.Code(err))
+	}
+	if _, err := server.DeleteComment(bob, &proto.DeleteCommentRequest{CommentId: commentID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteComment() by non-owner code = %v, want PermissionDenied", status.Code(err))
+	}
+
+	editResp, err := server.EditComment(alice, &proto.EditCommentRequest{CommentId: commentID, Content: "Edited"})
+	if err != nil || editResp.Error != "" {
+		t.Fatalf("EditComment() by owner error = %v, %s", err, editResp.GetError())
+	}
+
+	deleteResp, err := server.DeleteComment(editor, &proto.DeleteCommentRequest{CommentId: commentID})
+	if err != nil || !deleteResp.Success {
+		t.Errorf("DeleteComment() by editor error = %v, %s", err, deleteResp.GetError())
+	}
+}
+
+func TestCommentServer_Moderation(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	moderator := moderation.NewModerator(&moderation.Config{
+		MaxLinks:         1,
+		BlockedWords:     []string{"casino"},
+		NewCommenterHold: 1,
+	})
+	server := NewCommentServer(memoryStorage, authz.DefaultPolicy(), moderator)
+	postID := newCommentTestPost(t, memoryStorage)
+
+	alice := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice"})
+	editor := auth.NewContext(context.Background(), &auth.Identity{Subject: "carol", Roles: []string{"editor"}})
+
+	held, err := server.AddComment(alice, &proto.AddCommentRequest{PostId: postID, Content: "First!"})
+	if err != nil || held.Error != "" {
+		t.Fatalf("AddComment() error = %v, %s", err, held.GetError())
+	}
+	if held.Comment.ModerationState != proto.ModerationState_MODERATION_STATE_PENDING {
+		t.Fatalf("AddComment() by new commenter state = %v, want pending", held.Comment.ModerationState)
+	}
+
+	list, err := server.ListComments(alice, &proto.ListCommentsRequest{PostId: postID})
+	if err != nil || len(list.Comments) != 0 {
+		t.Errorf("ListComments() = %v, %v, want pending comment hidden", list.GetComments(), err)
+	}
+
+	queue, err := server.ListModerationQueue(editor, &proto.ListModerationQueueRequest{})
+	if err != nil || queue.Error != "" {
+		t.Fatalf("ListModerationQueue() error = %v, %s", err, queue.GetError())
+	}
+	if len(queue.Comments) != 1 || queue.Comments[0].CommentId != held.Comment.CommentId {
+		t.Fatalf("ListModerationQueue() = %v, want held comment", queue.Comments)
+	}
+
+	missingState, err := server.ModerateComment(editor, &proto.ModerateCommentRequest{CommentId: held.Comment.CommentId})
+	if err != nil || missingState.Error == "" {
+		t.Errorf("ModerateComment() without state = %v, %q, want error in response", err, missingState.GetError())
+	}
+
+	approved, err := server.ModerateComment(editor, &proto.ModerateCommentRequest{
+		CommentId: held.Comment.CommentId,
+		State:     proto.ModerationState_MODERATION_STATE_APPROVED,
+	})
+	if err != nil || approved.Error != "" {
+		t.Fatalf("ModerateComment() error = %v, %s", err, approved.GetError())
+	}
+	if approved.Comment.ModeratedBy != "carol" {
+		t.Errorf("ModerateComment() moderated_by = %v, want carol", approved.Comment.ModeratedBy)
+	}
+
+	second, err := server.AddComment(alice, &proto.AddCommentRequest{PostId: postID, Content: "Second"})
+	if err != nil || second.Comment.GetModerationState() != proto.ModerationState_MODERATION_STATE_APPROVED {
+		t.Errorf("AddComment() by approved commenter = %v, %v, want approved", second.GetComment(), err)
+	}
+
+	edited, err := server.EditComment(alice, &proto.EditCommentRequest{CommentId: second.Comment.CommentId, Content: "Play casino"})
+	if err != nil || edited.Comment.GetModerationState() != proto.ModerationState_MODERATION_STATE_SPAM {
+		t.Errorf("EditComment() with blocked word = %v, %v, want spam", edited.GetComment(), err)
+	}
+
+	list, err = server.ListComments(alice, &proto.ListCommentsRequest{PostId: postID})
+	if err != nil || len(list.Comments) != 1 || list.Comments[0].CommentId != held.Comment.CommentId {
+		t.Errorf("ListComments() = %v, %v, want only the approved comment", list.GetComments(), err)
+	}
+}
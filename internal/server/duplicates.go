@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DuplicatePolicy decides what CreatePost does with near-duplicate content.
+type DuplicatePolicy string
+
+const (
+	// DuplicateWarn creates the post and lists the similar posts in the
+	// response.
+	DuplicateWarn DuplicatePolicy = "warn"
+	// DuplicateReject refuses the post with ALREADY_EXISTS.
+	DuplicateReject DuplicatePolicy = "reject"
+	// DuplicateIgnore skips the check.
+	DuplicateIgnore DuplicatePolicy = "ignore"
+)
+
+// ParseDuplicatePolicy converts a flag value to a DuplicatePolicy.
+func ParseDuplicatePolicy(value string) (DuplicatePolicy, error) {
+	switch p := DuplicatePolicy(value); p {
+	case DuplicateWarn, DuplicateReject, DuplicateIgnore:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown duplicate policy %q, expected warn, reject or ignore", value)
+}
+
+// WithDuplicatePolicy sets how CreatePost handles near-duplicate content.
+// The default is DuplicateWarn.
+func WithDuplicatePolicy(p DuplicatePolicy) Option {
+	return func(s *BlogServer) {
+		s.duplicatePolicy = p
+	}
+}
+
+// duplicateCheck returns the check CreatePost asks storage to run under
+// the policy, or nil when duplicates are ignored.
+func (s *BlogServer) duplicateCheck() *storage.DuplicateCheck {
+	if s.duplicatePolicy == DuplicateIgnore {
+		return nil
+	}
+	return &storage.DuplicateCheck{Reject: s.duplicatePolicy == DuplicateReject}
+}
+
+// duplicateWarnings returns the near duplicates found by check, or an
+// ALREADY_EXISTS error when err reports that the post was rejected as one.
+func duplicateWarnings(ctx context.Context, check *storage.DuplicateCheck, err error) ([]*proto.DuplicateMatch, error) {
+	var dup *storage.DuplicateError
+	if errors.As(err, &dup) {
+		slog.WarnContext(ctx, "Near-duplicate content", "post_id", dup.Matches[0].PostID, "similarity", dup.Matches[0].Similarity)
+		return nil, status.Error(codes.AlreadyExists, dup.Error())
+	}
+	if err != nil || check == nil || len(check.Matches) == 0 {
+		return nil, err
+	}
+	slog.WarnContext(ctx, "Near-duplicate content", "post_id", check.Matches[0].PostID, "similarity", check.Matches[0].Similarity)
+
+	warnings := make([]*proto.DuplicateMatch, 0, len(check.Matches))
+	for _, m := range check.Matches {
+		warnings = append(warnings, &proto.DuplicateMatch{
+			PostId:     m.PostID,
+			Similarity: m.Similarity,
+		})
+	}
+	return warnings, nil
+}
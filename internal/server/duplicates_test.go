@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/idempotency"
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const duplicateContent = "Context cancellation in Go lets a server stop work as soon as the client goes away, saving resources"
+
+func newDuplicateRequest(title string) *proto.CreatePostRequest {
+	return &proto.CreatePostRequest{
+		Title:           title,
+		Content:         duplicateContent,
+		Author:          "alice",
+		PublicationDate: timestamppb.New(time.Now()),
+	}
+}
+
+func TestBlogServer_DuplicateWarn(t *testing.T) {
+	server := NewBlogServer(storage.NewMemoryStorage(), WithIdempotency(idempotency.NewStore(time.Hour)))
+	ctx := context.Background()
+
+	first, err := server.CreatePost(ctx, newDuplicateRequest("Original"))
+	if err != nil || first.Error != "" {
+		t.Fatalf("CreatePost() error = %v, %s", err, first.GetError())
+	}
+	if len(first.DuplicateWarnings) != 0 {
+		t.Errorf("CreatePost() warnings = %v, want none", first.DuplicateWarnings)
+	}
+
+	req := newDuplicateRequest("Copy")
+	req.IdempotencyKey = "copy-1"
+	second, err := server.CreatePost(ctx, req)
+	if err != nil || second.Error != "" {
+		t.Fatalf("CreatePost() error = %v, %s", err, second.GetError())
+	}
+	if len(second.DuplicateWarnings) != 1 || second.DuplicateWarnings[0].PostId != first.Post.PostId {
+		t.Fatalf("CreatePost() warnings = %v, want %s", second.DuplicateWarnings, first.Post.PostId)
+	}
+
+	// A retry replays the original warnings rather than flagging the post
+	// it created.
+	retry, err := server.CreatePost(ctx, req)
+	if err != nil || !retry.Replayed {
+		t.Fatalf("CreatePost() retry = %v, %v, want replayed", retry, err)
+	}
+	if len(retry.DuplicateWarnings) != 1 || retry.DuplicateWarnings[0].PostId != first.Post.PostId {
+		t.Errorf("CreatePost() retry warnings = %v, want %s", retry.DuplicateWarnings, first.Post.PostId)
+	}
+}
+
+func TestBlogServer_DuplicateReject(t *testing.T) {
+	server := NewBlogServer(storage.NewMemoryStorage(), WithDuplicatePolicy(DuplicateReject))
+	ctx := context.Background()
+
+	if resp, err := server.CreatePost(ctx, newDuplicateRequest("Original")); err != nil || resp.Error != "" {
+		t.Fatalf("CreatePost() error = %v, %s", err, resp.GetError())
+	}
+	if _, err := server.CreatePost(ctx, newDuplicateRequest("Copy")); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreatePost() duplicate code = %v, want AlreadyExists", status.Code(err))
+	}
+
+	different := newDuplicateRequest("Different")
+	different.Content = "An entirely different article about tuning PostgreSQL autovacuum"
+	if resp, err := server.CreatePost(ctx, different); err != nil || resp.Error != "" {
+		t.Errorf("CreatePost() different content error = %v, %s", err, resp.GetError())
+	}
+}
+
+func TestParseDuplicatePolicy(t *testing.T) {
+	for _, value := range []string{"warn", "reject", "ignore"} {
+		if _, err := ParseDuplicatePolicy(value); err != nil {
+			t.Errorf("ParseDuplicatePolicy(%q) error = %v", value, err)
+		}
+	}
+	if _, err := ParseDuplicatePolicy("block"); err == nil {
+		t.Error("ParseDuplicatePolicy(block) expected error")
+	}
+}
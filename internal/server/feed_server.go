@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/feed"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/tagging"
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+// feedWindow is how many of the newest posts a home feed covers. It also
+// caps how many posts are taken from each followed source, so that an
+// author with many posts costs no more to merge than any other.
+const feedWindow = 500
+
+type FeedServer struct {
+	proto.UnimplementedFeedServiceServer
+	storage    *storage.MemoryStorage
+	normalizer *tagging.Normalizer
+	cache      *feed.Cache
+}
+
+// NewFeedServer creates a FeedServer. Home feeds are merged from the
+// followed sources when read and kept in cache; followed tags are
+// normalized with normalizer.
+func NewFeedServer(storage *storage.MemoryStorage, normalizer *tagging.Normalizer, cache *feed.Cache) *FeedServer {
+	return &FeedServer{
+		storage:    storage,
+		normalizer: normalizer,
+		cache:      cache,
+	}
+}
+
+func (s *FeedServer) Follow(ctx context.Context, req *proto.FollowRequest) (*proto.FollowResponse, error) {
+	ctx, span := tracer.Start(ctx, "FeedServer.Follow")
+	defer span.End()
+
+	user := callerAuthor(ctx, req.User)
+	slog.InfoContext(ctx, "Following", "user", user, "author_id", req.AuthorId, "tag", req.Tag)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.FollowResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := checkFollowTarget(user, req.AuthorId, req.Tag); err != nil {
+		return &proto.FollowResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	if err := s.storage.Follow(ctx, user, req.AuthorId, s.followedTag(req.Tag)); err != nil {
+		slog.WarnContext(ctx, "Failed to follow", "user", user, "error", err)
+		return &proto.FollowResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	s.cache.Invalidate(user)
+
+	return &proto.FollowResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *FeedServer) Unfollow(ctx context.Context, req *proto.UnfollowRequest) (*proto.UnfollowResponse, error) {
+	ctx, span := tracer.Start(ctx, "FeedServer.Unfollow")
+	defer span.End()
+
+	user := callerAuthor(ctx, req.User)
+	slog.InfoContext(ctx, "Unfollowing", "user", user, "author_id", req.AuthorId, "tag", req.Tag)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.UnfollowResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := checkFollowTarget(user, req.AuthorId, req.Tag); err != nil {
+		return &proto.UnfollowResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	s.storage.Unfollow(ctx, user, req.AuthorId, s.followedTag(req.Tag))
+	s.cache.Invalidate(user)
+
+	return &proto.UnfollowResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *FeedServer) ListFollowing(ctx context.Context, req *proto.ListFollowingRequest) (*proto.ListFollowingResponse, error) {
+	ctx, span := tracer.Start(ctx, "FeedServer.ListFollowing")
+	defer span.End()
+
+	user := callerAuthor(ctx, req.User)
+	slog.InfoContext(ctx, "Listing followed sources", "user", user)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ListFollowingResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if user == "" {
+		return &proto.ListFollowingResponse{
+			Error: "user is required",
+		}, nil
+	}
+
+	authors, tags := s.storage.Following(ctx, user)
+	return &proto.ListFollowingResponse{
+		AuthorIds: authors,
+		Tags:      tags,
+	}, nil
+}
+
+func (s *FeedServer) GetHomeFeed(ctx context.Context, req *proto.GetHomeFeedRequest) (*proto.GetHomeFeedResponse, error) {
+	ctx, span := tracer.Start(ctx, "FeedServer.GetHomeFeed")
+	defer span.End()
+
+	user := callerAuthor(ctx, req.User)
+	slog.InfoContext(ctx, "Getting home feed", "user", user)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.GetHomeFeedResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if user == "" {
+		return &proto.GetHomeFeedResponse{
+			Error: "user is required",
+		}, nil
+	}
+	after, err := decodeFeedToken(req.PageToken)
+	if err != nil {
+		return &proto.GetHomeFeedResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	entries := s.cache.Get(user, func() []feed.Entry {
+		slog.DebugContext(ctx, "Building home feed", "user", user)
+		return feed.Merge(s.storage.FeedSources(ctx, user, feedWindow, time.Now()), feedWindow)
+	})
+
+	// Pages continue after the last post returned rather than at an
+	// offset, so posts published while paging do not shift later pages.
+	start := 0
+	if after != nil {
+		for start < len(entries) && !after.Newer(entries[start]) {
+			start++
+		}
+	}
+	page, _ := paginate(entries, start, int(req.PageSize))
+
+	ids := make([]string, len(page))
+	for i, e := range page {
+		ids[i] = e.PostID
+	}
+	resp := &proto.GetHomeFeedResponse{
+		Posts: s.storage.GetPosts(ctx, ids),
+	}
+	if len(page) > 0 && start+len(page) < len(entries) {
+		resp.NextPageToken = encodeFeedToken(page[len(page)-1])
+	}
+	return resp, nil
+}
+
+// followedTag normalizes a followed tag like the tags of new posts.
+func (s *FeedServer) followedTag(tag string) string {
+	if tag == "" {
+		return ""
+	}
+	return s.normalizer.Tag(tag)
+}
+
+func checkFollowTarget(user, authorID, tag string) error {
+	if user == "" {
+		return fmt.Errorf("user is required")
+	}
+	if (authorID == "") == (tag == "") {
+		return fmt.Errorf("exactly one of author_id and tag is required")
+	}
+	return nil
+}
+
+func encodeFeedToken(e feed.Entry) string {
+	cursor := strconv.FormatInt(e.Published.UnixNano(), 10) + ":" + e.PostID
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodeFeedToken(token string) (*feed.Entry, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page_token")
+	}
+	nanos, postID, ok := strings.Cut(string(data), ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid page_token")
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page_token")
+	}
+	return &feed.Entry{PostID: postID, Published: time.Unix(0, n)}, nil
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/feed"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/tagging"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFeedServer(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	feedServer := NewFeedServer(memoryStorage, tagging.NewNormalizer(tagging.DefaultConfig()), feed.NewCache(time.Hour, 10))
+	ctx := context.Background()
+	base := time.Now().Add(-time.Hour)
+
+	var aliceIDs []string
+	for i := 0; i < 5; i++ {
+		post, _ := memoryStorage.CreatePost(ctx, "Alice", "Content", "alice", timestamppb.New(base.Add(time.Duration(i)*time.Minute)), nil)
+		aliceIDs = append(aliceIDs, post.PostId)
+	}
+	tagged, _ := memoryStorage.CreatePost(ctx, "Tagged", "Content", "bob", timestamppb.New(base.Add(90*time.Second)), []string{"go"})
+
+	invalid := []struct {
+		name string
+		req  *proto.FollowRequest
+	}{
+		{name: "no target", req: &proto.FollowRequest{User: "reader"}},
+		{name: "both targets", req: &proto.FollowRequest{User: "reader", AuthorId: "alice", Tag: "go"}},
+		{name: "no user", req: &proto.FollowRequest{AuthorId: "alice"}},
+		{name: "unknown author", req: &proto.FollowRequest{User: "reader", AuthorId: "carol"}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := feedServer.Follow(ctx, tt.req)
+			if err != nil || resp.Error == "" || resp.Success {
+				t.Errorf("Follow() = %v, %v, want error in response", resp, err)
+			}
+		})
+	}
+
+	for _, req := range []*proto.FollowRequest{
+		{User: "reader", AuthorId: "alice"},
+		{User: "reader", Tag: "Go"},
+	} {
+		if resp, err := feedServer.Follow(ctx, req); err != nil || !resp.Success {
+			t.Fatalf("Follow() = %v, %v", resp, err)
+		}
+	}
+
+	following, err := feedServer.ListFollowing(ctx, &proto.ListFollowingRequest{User: "reader"})
+	if err != nil || !reflect.DeepEqual(following.AuthorIds, []string{"alice"}) || !reflect.DeepEqual(following.Tags, []string{"go"}) {
+		t.Errorf("ListFollowing() = %v, %v", following, err)
+	}
+
+	readFeed := func() []string {
+		var ids []string
+		var token string
+		for {
+			resp, err := feedServer.GetHomeFeed(ctx, &proto.GetHomeFeedRequest{User: "reader", PageSize: 2, PageToken: token})
+			if err != nil || resp.Error != "" {
+				t.Fatalf("GetHomeFeed() = %v, %v", resp, err)
+			}
+			for _, p := range resp.Posts {
+				ids = append(ids, p.PostId)
+			}
+			if token = resp.NextPageToken; token == "" {
+				return ids
+			}
+		}
+	}
+
+	want := []string{aliceIDs[4], aliceIDs[3], aliceIDs[2], tagged.PostId, aliceIDs[1], aliceIDs[0]}
+	if got := readFeed(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHomeFeed() = %v, want %v", got, want)
+	}
+
+	// The feed is cached, so a new post shows up only once the cache
+	// expires or the reader's subscriptions change.
+	newest, _ := memoryStorage.CreatePost(ctx, "Newest", "Content", "alice", timestamppb.New(time.Now()), nil)
+	if got := readFeed(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHomeFeed() from cache = %v, want %v", got, want)
+	}
+
+	if resp, err := feedServer.Unfollow(ctx, &proto.UnfollowRequest{User: "reader", Tag: "go"}); err != nil || !resp.Success {
+		t.Fatalf("Unfollow() = %v, %v", resp, err)
+	}
+	want = []string{newest.PostId, aliceIDs[4], aliceIDs[3], aliceIDs[2], aliceIDs[1], aliceIDs[0]}
+	if got := readFeed(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHomeFeed() after Unfollow() = %v, want %v", got, want)
+	}
+
+	bad, err := feedServer.GetHomeFeed(ctx, &proto.GetHomeFeedRequest{User: "reader", PageToken: "!!"})
+	if err != nil || bad.Error == "" {
+		t.Errorf("GetHomeFeed() with invalid token = %v, %v, want error in response", bad, err)
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+// DefaultReactionTypes are the reactions accepted when the server is not
+// configured with WithReactionTypes.
+var DefaultReactionTypes = []string{"like", "clap", "love", "insightful"}
+
+// WithReactionTypes sets the reaction types ReactToPost accepts.
+func WithReactionTypes(types ...string) Option {
+	return func(s *BlogServer) {
+		s.reactionTypes = types
+	}
+}
+
+func (s *BlogServer) ReactToPost(ctx context.Context, req *proto.ReactToPostRequest) (*proto.ReactToPostResponse, error) {
+	ctx, span := tracer.Start(ctx, "BlogServer.ReactToPost")
+	defer span.End()
+
+	user := callerAuthor(ctx, req.User)
+	slog.InfoContext(ctx, "Reacting to post", "post_id", req.PostId, "reaction", req.Reaction, "user", user)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ReactToPostResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := s.checkReaction(req.Reaction); err != nil {
+		return &proto.ReactToPostResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if user == "" {
+		return &proto.ReactToPostResponse{
+			Error: "user is required",
+		}, nil
+	}
+
+	counts, err := s.storage.AddReaction(ctx, req.PostId, req.Reaction, user)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to react to post", "post_id", req.PostId, "error", err)
+		return &proto.ReactToPostResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &proto.ReactToPostResponse{
+		ReactionCounts: counts,
+	}, nil
+}
+
+func (s *BlogServer) RemoveReaction(ctx context.Context, req *proto.RemoveReactionRequest) (*proto.RemoveReactionResponse, error) {
+	ctx, span := tracer.Start(ctx, "BlogServer.RemoveReaction")
+	defer span.End()
+
+	user := callerAuthor(ctx, req.User)
+	slog.InfoContext(ctx, "Removing reaction", "post_id", req.PostId, "reaction", req.Reaction, "user", user)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.RemoveReactionResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := s.checkReaction(req.Reaction); err != nil {
+		return &proto.RemoveReactionResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if user == "" {
+		return &proto.RemoveReactionResponse{
+			Error: "user is required",
+		}, nil
+	}
+
+	counts, err := s.storage.RemoveReaction(ctx, req.PostId, req.Reaction, user)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to remove reaction", "post_id", req.PostId, "error", err)
+		return &proto.RemoveReactionResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &proto.RemoveReactionResponse{
+		ReactionCounts: counts,
+	}, nil
+}
+
+func (s *BlogServer) checkReaction(reaction string) error {
+	if !slices.Contains(s.reactionTypes, reaction) {
+		return fmt.Errorf("unsupported reaction %q, expected one of %v", reaction, s.reactionTypes)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBlogServer_Reactions(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage, WithReactionTypes("like", "clap"))
+	ctx := context.Background()
+
+	post, err := memoryStorage.CreatePost(ctx, "Post", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     *proto.ReactToPostRequest
+		wantErr bool
+	}{
+		{
+			name: "valid reaction",
+			ctx:  ctx,
+			req:  &proto.ReactToPostRequest{PostId: post.PostId, Reaction: "like", User: "alice"},
+		},
+		{
+			name: "authenticated reaction",
+			ctx:  auth.NewContext(ctx, &auth.Identity{Subject: "bob"}),
+			req:  &proto.ReactToPostRequest{PostId: post.PostId, Reaction: "like"},
+		},
+		{
+			name:    "unsupported reaction",
+			ctx:     ctx,
+			req:     &proto.ReactToPostRequest{PostId: post.PostId, Reaction: "love", User: "alice"},
+			wantErr: true,
+		},
+		{
+			name:    "missing user",
+			ctx:     ctx,
+			req:     &proto.ReactToPostRequest{PostId: post.PostId, Reaction: "like"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown post",
+			ctx:     ctx,
+			req:     &proto.ReactToPostRequest{PostId: "missing", Reaction: "like", User: "alice"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.ReactToPost(tt.ctx, tt.req)
+			if err != nil {
+				t.Fatalf("ReactToPost() unexpected error = %v", err)
+			}
+			if tt.wantErr && resp.Error == "" {
+				t.Error("ReactToPost() expected error in response")
+			}
+			if !tt.wantErr && resp.Error != "" {
+				t.Errorf("ReactToPost() unexpected error in response = %v", resp.Error)
+			}
+		})
+	}
+
+	readResp, err := server.ReadPost(ctx, &proto.ReadPostRequest{PostId: post.PostId})
+	if err != nil || readResp.Error != "" {
+		t.Fatalf("ReadPost() error = %v, %s", err, readResp.GetError())
+	}
+	if readResp.Post.ReactionCounts["like"] != 2 {
+		t.Errorf("ReadPost() reaction counts = %v, want like=2", readResp.Post.ReactionCounts)
+	}
+
+	removeResp, err := server.RemoveReaction(ctx, &proto.RemoveReactionRequest{PostId: post.PostId, Reaction: "like", User: "alice"})
+	if err != nil || removeResp.Error != "" {
+		t.Fatalf("RemoveReaction() error = %v, %s", err, removeResp.GetError())
+	}
+	if removeResp.ReactionCounts["like"] != 1 {
+		t.Errorf("RemoveReaction() counts = %v, want like=1", removeResp.ReactionCounts)
+	}
+}
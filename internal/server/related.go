@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+const (
+	defaultRelatedLimit = 5
+	maxRelatedLimit     = 20
+)
+
+func (s *BlogServer) GetRelatedPosts(ctx context.Context, req *proto.GetRelatedPostsRequest) (*proto.GetRelatedPostsResponse, error) {
+	ctx, span := tracer.Start(ctx, "BlogServer.GetRelatedPosts")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Getting related posts", "post_id", req.PostId, "limit", req.Limit)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.GetRelatedPostsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultRelatedLimit
+	}
+	if limit > maxRelatedLimit {
+		limit = maxRelatedLimit
+	}
+
+	related, err := s.storage.RelatedPosts(ctx, req.PostId, limit)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get related posts", "post_id", req.PostId, "error", err)
+		return &proto.GetRelatedPostsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	resp := &proto.GetRelatedPostsResponse{}
+	for _, r := range related {
+		resp.Posts = append(resp.Posts, &proto.RelatedPost{
+			Post:  r.Post,
+			Score: r.Score,
+		})
+	}
+	return resp, nil
+}
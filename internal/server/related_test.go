@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBlogServer_GetRelatedPosts(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage)
+	ctx := context.Background()
+
+	create := func(title, content string, tags ...string) string {
+		resp, err := server.CreatePost(ctx, &proto.CreatePostRequest{
+			Title:           title,
+			Content:         content,
+			Author:          "Author",
+			PublicationDate: timestamppb.New(time.Now()),
+			Tags:            tags,
+		})
+		if err != nil || resp.Error != "" {
+			t.Fatalf("CreatePost() error = %v, %s", err, resp.GetError())
+		}
+		return resp.Post.PostId
+	}
+
+	base := create("Profiling", "Profiling Go programs with pprof", "go", "performance")
+	related := create("Benchmarks", "Writing Go benchmarks", "go")
+	create("Unrelated", "Knitting patterns", "crafts")
+
+	tests := []struct {
+		name      string
+		req       *proto.GetRelatedPostsRequest
+		wantPosts int
+		wantErr   bool
+	}{
+		{name: "related posts", req: &proto.GetRelatedPostsRequest{PostId: base}, wantPosts: 1},
+		{name: "missing post id", req: &proto.GetRelatedPostsRequest{}, wantErr: true},
+		{name: "unknown post", req: &proto.GetRelatedPostsRequest{PostId: "missing"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.GetRelatedPosts(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("GetRelatedPosts() unexpected error = %v", err)
+			}
+			if tt.wantErr {
+				if resp.Error == "" {
+					t.Error("GetRelatedPosts() expected error in response")
+				}
+				return
+			}
+			if len(resp.Posts) != tt.wantPosts {
+				t.Fatalf("GetRelatedPosts() returned %d posts, want %d", len(resp.Posts), tt.wantPosts)
+			}
+			if resp.Posts[0].Post.PostId != related {
+				t.Errorf("GetRelatedPosts() = %s, want %s", resp.Posts[0].Post.PostId, related)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+type SeriesServer struct {
+	proto.UnimplementedSeriesServiceServer
+	storage *storage.MemoryStorage
+	policy  *authz.Policy
+}
+
+// NewSeriesServer creates a SeriesServer. When policy is set, only a
+// series' author or a role allowed to modify anything may change it, and
+// only posts the caller may modify can be added to it.
+func NewSeriesServer(storage *storage.MemoryStorage, policy *authz.Policy) *SeriesServer {
+	return &SeriesServer{
+		storage: storage,
+		policy:  policy,
+	}
+}
+
+func (s *SeriesServer) CreateSeries(ctx context.Context, req *proto.CreateSeriesRequest) (*proto.CreateSeriesResponse, error) {
+	ctx, span := tracer.Start(ctx, "SeriesServer.CreateSeries")
+	defer span.End()
+
+	author := callerAuthor(ctx, req.Author)
+	slog.InfoContext(ctx, "Creating series", "title", req.Title, "author", author)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.CreateSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if author == "" {
+		return &proto.CreateSeriesResponse{
+			Error: "author is required",
+		}, nil
+	}
+
+	series, err := s.storage.CreateSeries(ctx, req.Title, req.Description, author)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create series", "error", err)
+		return &proto.CreateSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Series created successfully", "series_id", series.SeriesId)
+	return &proto.CreateSeriesResponse{
+		Series: series,
+	}, nil
+}
+
+func (s *SeriesServer) AddPostToSeries(ctx context.Context, req *proto.AddPostToSeriesRequest) (*proto.AddPostToSeriesResponse, error) {
+	ctx, span := tracer.Start(ctx, "SeriesServer.AddPostToSeries")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Adding post to series", "series_id", req.SeriesId, "post_id", req.PostId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.AddPostToSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	series, err := s.storage.GetSeries(ctx, req.SeriesId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to add post to series", "series_id", req.SeriesId, "error", err)
+		return &proto.AddPostToSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := checkOwner(ctx, s.policy, "series", series.SeriesId, series.Author); err != nil {
+		slog.WarnContext(ctx, "Adding post to series denied", "series_id", req.SeriesId, "error", err)
+		return nil, err
+	}
+	post, err := s.storage.GetPost(ctx, req.PostId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to add post to series", "post_id", req.PostId, "error", err)
+		return &proto.AddPostToSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := checkOwnership(ctx, s.policy, post); err != nil {
+		slog.WarnContext(ctx, "Adding post to series denied", "post_id", req.PostId, "error", err)
+		return nil, err
+	}
+
+	series, err = s.storage.AddPostToSeries(ctx, req.SeriesId, req.PostId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to add post to series", "series_id", req.SeriesId, "post_id", req.PostId, "error", err)
+		return &proto.AddPostToSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Post added to series successfully", "series_id", series.SeriesId, "post_id", req.PostId)
+	return &proto.AddPostToSeriesResponse{
+		Series: series,
+	}, nil
+}
+
+func (s *SeriesServer) ReorderSeries(ctx context.Context, req *proto.ReorderSeriesRequest) (*proto.ReorderSeriesResponse, error) {
+	ctx, span := tracer.Start(ctx, "SeriesServer.ReorderSeries")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Reordering series", "series_id", req.SeriesId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ReorderSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	series, err := s.storage.GetSeries(ctx, req.SeriesId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to reorder series", "series_id", req.SeriesId, "error", err)
+		return &proto.ReorderSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := checkOwner(ctx, s.policy, "series", series.SeriesId, series.Author); err != nil {
+		slog.WarnContext(ctx, "Reordering series denied", "series_id", req.SeriesId, "error", err)
+		return nil, err
+	}
+
+	series, err = s.storage.ReorderSeries(ctx, req.SeriesId, req.PostIds)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to reorder series", "series_id", req.SeriesId, "error", err)
+		return &proto.ReorderSeriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Series reordered successfully", "series_id", series.SeriesId)
+	return &proto.ReorderSeriesResponse{
+		Series: series,
+	}, nil
+}
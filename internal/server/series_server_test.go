@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSeriesServer(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	policy := authz.DefaultPolicy()
+	blogServer := NewBlogServer(memoryStorage, WithPolicy(policy))
+	seriesServer := NewSeriesServer(memoryStorage, policy)
+	alice := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice", Roles: []string{"author"}})
+	bob := auth.NewContext(context.Background(), &auth.Identity{Subject: "bob", Roles: []string{"author"}})
+
+	created, err := seriesServer.CreateSeries(alice, &proto.CreateSeriesRequest{Title: "Tutorial"})
+	if err != nil || created.Error != "" {
+		t.Fatalf("CreateSeries() = %v, %v", created, err)
+	}
+	if created.Series.Author != "alice" {
+		t.Errorf("CreateSeries() author = %s, want alice", created.Series.Author)
+	}
+	seriesID := created.Series.SeriesId
+
+	var ids []string
+	for _, title := range []string{"Part 1", "Part 2"} {
+		resp, err := blogServer.CreatePost(alice, &proto.CreatePostRequest{
+			Title:           title,
+			Content:         "Content of " + title,
+			PublicationDate: timestamppb.New(time.Now()),
+		})
+		if err != nil || resp.Error != "" {
+			t.Fatalf("CreatePost() = %v, %v", resp, err)
+		}
+		added, err := seriesServer.AddPostToSeries(alice, &proto.AddPostToSeriesRequest{SeriesId: seriesID, PostId: resp.Post.PostId})
+		if err != nil || added.Error != "" {
+			t.Fatalf("AddPostToSeries() = %v, %v", added, err)
+		}
+		ids = append(ids, resp.Post.PostId)
+	}
+
+	bobsPost, _ := blogServer.CreatePost(bob, &proto.CreatePostRequest{
+		Title:           "Bob's post",
+		Content:         "Something else entirely",
+		PublicationDate: timestamppb.New(time.Now()),
+	})
+	bobsSeries, _ := seriesServer.CreateSeries(bob, &proto.CreateSeriesRequest{Title: "Bob's series"})
+
+	denied := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "add to someone else's series",
+			call: func() error {
+				_, err := seriesServer.AddPostToSeries(bob, &proto.AddPostToSeriesRequest{SeriesId: seriesID, PostId: bobsPost.Post.PostId})
+				return err
+			},
+		},
+		{
+			name: "add someone else's post",
+			call: func() error {
+				_, err := seriesServer.AddPostToSeries(bob, &proto.AddPostToSeriesRequest{SeriesId: bobsSeries.Series.SeriesId, PostId: ids[0]})
+				return err
+			},
+		},
+		{
+			name: "reorder someone else's series",
+			call: func() error {
+				_, err := seriesServer.ReorderSeries(bob, &proto.ReorderSeriesRequest{SeriesId: seriesID, PostIds: []string{ids[1], ids[0]}})
+				return err
+			},
+		},
+	}
+	for _, tt := range denied {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != codes.PermissionDenied {
+				t.Errorf("error = %v, want PermissionDenied", err)
+			}
+		})
+	}
+
+	reordered, err := seriesServer.ReorderSeries(alice, &proto.ReorderSeriesRequest{SeriesId: seriesID, PostIds: []string{ids[1], ids[0]}})
+	if err != nil || reordered.Error != "" {
+		t.Fatalf("ReorderSeries() = %v, %v", reordered, err)
+	}
+	incomplete, err := seriesServer.ReorderSeries(alice, &proto.ReorderSeriesRequest{SeriesId: seriesID, PostIds: []string{ids[1]}})
+	if err != nil || incomplete.Error == "" {
+		t.Errorf("ReorderSeries() missing a post = %v, %v, want error in response", incomplete, err)
+	}
+
+	read, err := blogServer.ReadPost(bob, &proto.ReadPostRequest{PostId: ids[0]})
+	if err != nil || read.Error != "" {
+		t.Fatalf("ReadPost() = %v, %v", read, err)
+	}
+	if nav := read.Series; nav == nil || nav.Position != 2 || nav.Total != 2 || nav.PreviousPostId != ids[1] || nav.NextPostId != "" {
+		t.Errorf("ReadPost() series = %v, want position 2 of 2 after %s", nav, ids[1])
+	}
+
+	standalone, _ := blogServer.ReadPost(bob, &proto.ReadPostRequest{PostId: bobsPost.Post.PostId})
+	if standalone.Series != nil {
+		t.Errorf("ReadPost() of post outside a series = %v, want no series", standalone.Series)
+	}
+}
+
+func TestSeriesServer_CoAuthorAddsPost(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	policy := authz.DefaultPolicy()
+	seriesServer := NewSeriesServer(memoryStorage, policy)
+	alice := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice", Roles: []string{"author"}})
+	ctx := context.Background()
+
+	memoryStorage.CreateAuthor(ctx, &proto.Author{AuthorId: "alice", DisplayName: "Alice"})
+	post, err := memoryStorage.CreatePost(ctx, "Joint post", "Content", "bob", timestamppb.Now(), nil, storage.WithCoAuthors([]string{"alice"}))
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+	series, _ := seriesServer.CreateSeries(alice, &proto.CreateSeriesRequest{Title: "Tutorial"})
+
+	added, err := seriesServer.AddPostToSeries(alice, &proto.AddPostToSeriesRequest{SeriesId: series.Series.SeriesId, PostId: post.PostId})
+	if err != nil || added.Error != "" {
+		t.Fatalf("AddPostToSeries() by co-author = %v, %v", added, err)
+	}
+}
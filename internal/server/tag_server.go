@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"unicode/utf8"
+
+	"github.com/kpauljoseph/test/internal/feed"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/tagging"
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 50
+)
+
+type TagServer struct {
+	proto.UnimplementedTagServiceServer
+	storage    *storage.MemoryStorage
+	normalizer *tagging.Normalizer
+	feeds      *feed.Cache
+}
+
+// NewTagServer creates a TagServer. New tag names given to RenameTag and
+// MergeTags are normalized with normalizer. Renames and merges drop the
+// cached home feeds in feeds of the users following the tags involved.
+func NewTagServer(storage *storage.MemoryStorage, normalizer *tagging.Normalizer, feeds *feed.Cache) *TagServer {
+	return &TagServer{
+		storage:    storage,
+		normalizer: normalizer,
+		feeds:      feeds,
+	}
+}
+
+func (s *TagServer) ListTags(ctx context.Context, req *proto.ListTagsRequest) (*proto.ListTagsResponse, error) {
+	ctx, span := tracer.Start(ctx, "TagServer.ListTags")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing tags")
+
+	resp := &proto.ListTagsResponse{}
+	for _, c := range s.storage.TagCounts(ctx) {
+		resp.Tags = append(resp.Tags, &proto.TagCount{
+			Tag:       c.Tag,
+			PostCount: int32(c.Posts),
+		})
+	}
+	return resp, nil
+}
+
+func (s *TagServer) RenameTag(ctx context.Context, req *proto.RenameTagRequest) (*proto.RenameTagResponse, error) {
+	ctx, span := tracer.Start(ctx, "TagServer.RenameTag")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Renaming tag", "old_tag", req.OldTag, "new_tag", req.NewTag)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.RenameTagResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	// Old tags are matched as stored so that tags saved before a
+	// normalization rule existed can still be renamed.
+	change, err := s.storage.RenameTag(ctx, req.OldTag, s.normalizer.Tag(req.NewTag))
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to rename tag", "old_tag", req.OldTag, "error", err)
+		return &proto.RenameTagResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	s.invalidateFeeds(change)
+
+	slog.InfoContext(ctx, "Tag renamed successfully", "old_tag", req.OldTag, "posts_updated", change.Posts)
+	return &proto.RenameTagResponse{
+		PostsUpdated: int32(change.Posts),
+	}, nil
+}
+
+func (s *TagServer) MergeTags(ctx context.Context, req *proto.MergeTagsRequest) (*proto.MergeTagsResponse, error) {
+	ctx, span := tracer.Start(ctx, "TagServer.MergeTags")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Merging tags", "source_tags", req.SourceTags, "target_tag", req.TargetTag)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.MergeTagsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if len(req.SourceTags) == 0 {
+		return &proto.MergeTagsResponse{
+			Error: "source_tags is required",
+		}, nil
+	}
+
+	change, err := s.storage.MergeTags(ctx, req.SourceTags, s.normalizer.Tag(req.TargetTag))
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to merge tags", "source_tags", req.SourceTags, "error", err)
+		return &proto.MergeTagsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	s.invalidateFeeds(change)
+
+	slog.InfoContext(ctx, "Tags merged successfully", "target_tag", req.TargetTag, "posts_updated", change.Posts)
+	return &proto.MergeTagsResponse{
+		PostsUpdated: int32(change.Posts),
+	}, nil
+}
+
+// invalidateFeeds drops the cached home feeds that change made stale.
+func (s *TagServer) invalidateFeeds(change storage.TagChange) {
+	for _, user := range change.Followers {
+		s.feeds.Invalidate(user)
+	}
+}
+
+func (s *TagServer) SuggestTags(ctx context.Context, req *proto.SuggestTagsRequest) (*proto.SuggestTagsResponse, error) {
+	ctx, span := tracer.Start(ctx, "TagServer.SuggestTags")
+	defer span.End()
+
+	slog.DebugContext(ctx, "Suggesting tags", "query", req.Query)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.SuggestTagsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	query := s.normalizer.Tag(req.Query)
+	resp := &proto.SuggestTagsResponse{}
+	for _, sg := range s.storage.SuggestTags(ctx, query, maxSuggestDistance(query), limit) {
+		resp.Suggestions = append(resp.Suggestions, &proto.TagSuggestion{
+			Tag:       sg.Tag,
+			PostCount: int32(sg.Posts),
+			Distance:  int32(sg.Distance),
+		})
+	}
+	return resp, nil
+}
+
+// maxSuggestDistance allows more typos in longer queries; short ones would
+// otherwise match almost every tag.
+func maxSuggestDistance(query string) int {
+	switch n := utf8.RuneCountInString(query); {
+	case n < 3:
+		return 0
+	case n < 6:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/feed"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/tagging"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTagServer(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	normalizer := tagging.NewNormalizer(&tagging.Config{
+		CaseFold: true,
+		Trim:     true,
+		Synonyms: map[string]string{"golang": "go"},
+	})
+	blogServer := NewBlogServer(memoryStorage, WithTagNormalizer(normalizer))
+	tagServer := NewTagServer(memoryStorage, normalizer, feed.NewCache(time.Hour, 10))
+	ctx := context.Background()
+
+	createResp, err := blogServer.CreatePost(ctx, &proto.CreatePostRequest{
+		Title:           "Post",
+		Content:         "Content",
+		Author:          "Author",
+		PublicationDate: timestamppb.New(time.Now()),
+		Tags:            []string{"Golang", "GRPC", "go"},
+	})
+	if err != nil || createResp.Error != "" {
+		t.Fatalf("CreatePost() error = %v, %s", err, createResp.GetError())
+	}
+	if want := []string{"go", "grpc"}; !reflect.DeepEqual(createResp.Post.Tags, want) {
+		t.Errorf("CreatePost() tags = %v, want %v", createResp.Post.Tags, want)
+	}
+
+	renameResp, err := tagServer.RenameTag(ctx, &proto.RenameTagRequest{OldTag: "grpc", NewTag: "RPC"})
+	if err != nil || renameResp.Error != "" || renameResp.PostsUpdated != 1 {
+		t.Fatalf("RenameTag() = %v, %v", renameResp, err)
+	}
+
+	listResp, err := tagServer.ListTags(ctx, &proto.ListTagsRequest{})
+	if err != nil {
+		t.Fatalf("ListTags() error = %v", err)
+	}
+	var got []string
+	for _, tc := range listResp.Tags {
+		got = append(got, tc.Tag)
+	}
+	if want := []string{"go", "rpc"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListTags() = %v, want %v", got, want)
+	}
+
+	mergeResp, err := tagServer.MergeTags(ctx, &proto.MergeTagsRequest{SourceTags: []string{"rpc"}, TargetTag: "go"})
+	if err != nil || mergeResp.Error != "" || mergeResp.PostsUpdated != 1 {
+		t.Fatalf("MergeTags() = %v, %v", mergeResp, err)
+	}
+
+	empty, err := tagServer.MergeTags(ctx, &proto.MergeTagsRequest{TargetTag: "go"})
+	if err != nil || empty.Error == "" {
+		t.Errorf("MergeTags() without sources = %v, %v, want error in response", empty, err)
+	}
+	invalid, err := tagServer.RenameTag(ctx, &proto.RenameTagRequest{OldTag: "go", NewTag: "not a tag"})
+	if err != nil || invalid.Error == "" {
+		t.Errorf("RenameTag() to invalid tag = %v, %v, want error in response", invalid, err)
+	}
+}
+
+func TestTagServer_SuggestTags(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	tagServer := NewTagServer(memoryStorage, tagging.NewNormalizer(tagging.DefaultConfig()), feed.NewCache(time.Hour, 10))
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	memoryStorage.CreatePost(ctx, "A", "Content", "Author", date, []string{"javascript", "java"})
+	memoryStorage.CreatePost(ctx, "B", "Content", "Author", date, []string{"javascript"})
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "prefix ranked by usage", query: "Ja", want: []string{"javascript", "java"}},
+		{name: "typo", query: "javsc", want: []string{"javascript"}},
+		{name: "short queries are exact", query: "jx", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tagServer.SuggestTags(ctx, &proto.SuggestTagsRequest{Query: tt.query})
+			if err != nil || resp.Error != "" {
+				t.Fatalf("SuggestTags() error = %v, %s", err, resp.GetError())
+			}
+			var got []string
+			for _, s := range resp.Suggestions {
+				got = append(got, s.Tag)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SuggestTags(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagServer_RenameKeepsFollowedFeeds(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	normalizer := tagging.NewNormalizer(tagging.DefaultConfig())
+	cache := feed.NewCache(time.Hour, 10)
+	tagServer := NewTagServer(memoryStorage, normalizer, cache)
+	feedServer := NewFeedServer(memoryStorage, normalizer, cache)
+	ctx := context.Background()
+
+	memoryStorage.CreatePost(ctx, "Old", "Content", "Author", timestamppb.New(time.Now().Add(-time.Hour)), []string{"grpc"})
+	if resp, err := feedServer.Follow(ctx, &proto.FollowRequest{User: "reader", Tag: "grpc"}); err != nil || resp.Error != "" {
+		t.Fatalf("Follow() = %v, %v", resp, err)
+	}
+	feedOf := func() int {
+		resp, err := feedServer.GetHomeFeed(ctx, &proto.GetHomeFeedRequest{User: "reader"})
+		if err != nil || resp.Error != "" {
+			t.Fatalf("GetHomeFeed() = %v, %v", resp, err)
+		}
+		return len(resp.Posts)
+	}
+	if got := feedOf(); got != 1 {
+		t.Fatalf("home feed has %d posts, want 1", got)
+	}
+
+	// A post tagged after the rename shows up right away, because the
+	// rename dropped the cached feed.
+	if resp, err := tagServer.RenameTag(ctx, &proto.RenameTagRequest{OldTag: "grpc", NewTag: "rpc"}); err != nil || resp.Error != "" {
+		t.Fatalf("RenameTag() = %v, %v", resp, err)
+	}
+	memoryStorage.CreatePost(ctx, "New", "Content", "Author", timestamppb.New(time.Now()), []string{"rpc"})
+	if got := feedOf(); got != 2 {
+		t.Errorf("home feed after rename has %d posts, want 2", got)
+	}
+	following, _ := feedServer.ListFollowing(ctx, &proto.ListFollowingRequest{User: "reader"})
+	if want := []string{"rpc"}; !reflect.DeepEqual(following.Tags, want) {
+		t.Errorf("ListFollowing() tags = %v, want %v", following.Tags, want)
+	}
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+const (
+	defaultTrendingLimit = 10
+	maxTrendingLimit     = 50
+)
+
+func (s *BlogServer) ListTrendingPosts(ctx context.Context, req *proto.ListTrendingPostsRequest) (*proto.ListTrendingPostsResponse, error) {
+	ctx, span := tracer.Start(ctx, "BlogServer.ListTrendingPosts")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing trending posts", "tag", req.Tag, "limit", req.Limit)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ListTrendingPostsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultTrendingLimit
+	}
+	if limit > maxTrendingLimit {
+		limit = maxTrendingLimit
+	}
+
+	// Stored tags are normalized, so the filter must be too.
+	tag := s.tagNormalizer.Tag(req.Tag)
+	trending, err := s.storage.TrendingPosts(ctx, tag, limit, time.Now())
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list trending posts", "tag", tag, "error", err)
+		return &proto.ListTrendingPostsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	resp := &proto.ListTrendingPostsResponse{}
+	for _, t := range trending {
+		resp.Posts = append(resp.Posts, &proto.TrendingPost{
+			Post:  t.Post,
+			Score: t.Score,
+		})
+	}
+	return resp, nil
+}
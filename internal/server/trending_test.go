@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBlogServer_ListTrendingPosts(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage)
+	ctx := context.Background()
+
+	var ids []string
+	for _, title := range []string{"First", "Second", "Third"} {
+		post, err := memoryStorage.CreatePost(ctx, title, "Content", "Author", timestamppb.New(time.Now()), []string{"go"})
+		if err != nil {
+			t.Fatalf("CreatePost() error = %v", err)
+		}
+		ids = append(ids, post.PostId)
+	}
+
+	// The second post gets the most engagement, the first some, the third
+	// none.
+	for _, user := range []string{"alice", "bob"} {
+		if resp, err := server.ReactToPost(ctx, &proto.ReactToPostRequest{PostId: ids[1], Reaction: "like", User: user}); err != nil || resp.Error != "" {
+			t.Fatalf("ReactToPost() error = %v, %s", err, resp.GetError())
+		}
+	}
+	alice := auth.NewContext(ctx, &auth.Identity{Subject: "alice"})
+	if resp, err := server.RecordView(alice, &proto.RecordViewRequest{PostId: ids[0]}); err != nil || resp.Error != "" {
+		t.Fatalf("RecordView() error = %v, %s", err, resp.GetError())
+	}
+
+	resp, err := server.ListTrendingPosts(ctx, &proto.ListTrendingPostsRequest{Tag: "go"})
+	if err != nil || resp.Error != "" {
+		t.Fatalf("ListTrendingPosts() error = %v, %s", err, resp.GetError())
+	}
+	if len(resp.Posts) != 2 {
+		t.Fatalf("ListTrendingPosts() returned %d posts, want 2", len(resp.Posts))
+	}
+	if resp.Posts[0].Post.PostId != ids[1] || resp.Posts[1].Post.PostId != ids[0] {
+		t.Errorf("ListTrendingPosts() order = %s, %s, want %s, %s", resp.Posts[0].Post.PostId, resp.Posts[1].Post.PostId, ids[1], ids[0])
+	}
+	if resp.Posts[0].Post.ReactionCounts["like"] != 2 {
+		t.Errorf("ListTrendingPosts() reaction counts = %v, want like=2", resp.Posts[0].Post.ReactionCounts)
+	}
+
+	normalized, err := server.ListTrendingPosts(ctx, &proto.ListTrendingPostsRequest{Tag: " Go "})
+	if err != nil || len(normalized.Posts) != 2 {
+		t.Errorf("ListTrendingPosts() with unnormalized tag = %v, %v, want 2 posts", normalized.GetPosts(), err)
+	}
+
+	limited, err := server.ListTrendingPosts(ctx, &proto.ListTrendingPostsRequest{Limit: 1})
+	if err != nil || len(limited.Posts) != 1 {
+		t.Errorf("ListTrendingPosts() with limit 1 = %v, %v", limited.GetPosts(), err)
+	}
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/validation"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultViewWindow is how long repeated views by the same viewer are
+	// ignored when the server is not configured with WithViewWindow.
+	DefaultViewWindow = 30 * time.Minute
+
+	// maxStatsBuckets bounds the size of GetPostStats responses.
+	maxStatsBuckets = 1000
+)
+
+// WithViewWindow sets how long repeated views of a post by the same viewer
+// are ignored.
+func WithViewWindow(window time.Duration) Option {
+	return func(s *BlogServer) {
+		s.viewWindow = window
+	}
+}
+
+// WithViewsOnRead makes ReadPost record a view for the caller.
+func WithViewsOnRead() Option {
+	return func(s *BlogServer) {
+		s.viewsOnRead = true
+	}
+}
+
+func (s *BlogServer) RecordView(ctx context.Context, req *proto.RecordViewRequest) (*proto.RecordViewResponse, error) {
+	ctx, span := tracer.Start(ctx, "BlogServer.RecordView")
+	defer span.End()
+
+	viewer := s.viewerKey(ctx, req.Viewer)
+	slog.InfoContext(ctx, "Recording view", "post_id", req.PostId, "viewer", viewer)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.RecordViewResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if viewer == "" {
+		return &proto.RecordViewResponse{
+			Error: "viewer is required",
+		}, nil
+	}
+
+	counted, err := s.storage.RecordView(ctx, req.PostId, viewer, time.Now(), s.viewWindow)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to record view", "post_id", req.PostId, "error", err)
+		return &proto.RecordViewResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &proto.RecordViewResponse{
+		Counted: counted,
+	}, nil
+}
+
+func (s *BlogServer) GetPostStats(ctx context.Context, req *proto.GetPostStatsRequest) (*proto.GetPostStatsResponse, error) {
+	ctx, span := tracer.Start(ctx, "BlogServer.GetPostStats")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Getting post stats", "post_id", req.PostId, "granularity", req.Granularity)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.GetPostStatsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	size := 24 * time.Hour
+	if req.Granularity == proto.StatsGranularity_STATS_GRANULARITY_HOURLY {
+		size = time.Hour
+	}
+	start, end := req.StartTime.AsTime(), req.EndTime.AsTime()
+	if !end.After(start) {
+		return &proto.GetPostStatsResponse{
+			Error: "end_time must be after start_time",
+		}, nil
+	}
+	if end.Sub(start.Truncate(size)) > maxStatsBuckets*size {
+		return &proto.GetPostStatsResponse{
+			Error: "time range covers too many buckets, use a coarser granularity or a shorter range",
+		}, nil
+	}
+
+	// Stats are only visible to the post's author and roles that may
+	// modify any post.
+	post, err := s.storage.GetPost(ctx, req.PostId)
+	if err != nil {
+		slog.WarnContext(ctx, "Post not found", "post_id", req.PostId, "error", err)
+		return &proto.GetPostStatsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	if err := checkOwnership(ctx, s.policy, post); err != nil {
+		slog.WarnContext(ctx, "Stats denied", "post_id", req.PostId, "error", err)
+		return nil, err
+	}
+
+	buckets, err := s.storage.ViewCounts(ctx, req.PostId, start, end, size)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get post stats", "post_id", req.PostId, "error", err)
+		return &proto.GetPostStatsResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	resp := &proto.GetPostStatsResponse{}
+	for _, b := range buckets {
+		resp.Buckets = append(resp.Buckets, &proto.ViewBucket{
+			StartTime: timestamppb.New(b.Start),
+			Views:     b.Views,
+		})
+		resp.TotalViews += b.Views
+	}
+	return resp, nil
+}
+
+// recordReadView counts a ReadPost call as a view when WithViewsOnRead is
+// set. Failures are logged but do not fail the read.
+func (s *BlogServer) recordReadView(ctx context.Context, postID string) {
+	if !s.viewsOnRead {
+		return
+	}
+	viewer := s.viewerKey(ctx, "")
+	if viewer == "" {
+		return
+	}
+	if _, err := s.storage.RecordView(ctx, postID, viewer, time.Now(), s.viewWindow); err != nil {
+		slog.WarnContext(ctx, "Failed to record view", "post_id", postID, "error", err)
+	}
+}
+
+// viewerKey identifies a viewer for de-duplication: the authenticated
+// subject, else the peer's address. The requested viewer is only honored
+// for callers that may modify any post, such as a frontend recording views
+// on behalf of its users, so that other callers cannot inflate view counts
+// by naming a new viewer on every call.
+func (s *BlogServer) viewerKey(ctx context.Context, requested string) string {
+	if id, ok := auth.FromContext(ctx); ok {
+		if requested != "" && s.policy != nil && s.policy.CanModify(id, "") {
+			return "viewer:" + requested
+		}
+		return "id:" + id.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		return "peer:" + addr
+	}
+	return ""
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/auth"
+	"github.com/kpauljoseph/test/internal/authz"
+	"github.com/kpauljoseph/test/internal/storage"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBlogServer_Views(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage, WithPolicy(authz.DefaultPolicy()), WithViewsOnRead())
+
+	alice := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice", Roles: []string{"author"}})
+	bob := auth.NewContext(context.Background(), &auth.Identity{Subject: "bob", Roles: []string{"author"}})
+
+	post, err := memoryStorage.CreatePost(alice, "Post", "Content", "alice", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	first, err := server.RecordView(bob, &proto.RecordViewRequest{PostId: post.PostId})
+	if err != nil || !first.Counted {
+		t.Fatalf("RecordView() = %v, %v, want counted", first, err)
+	}
+	repeat, err := server.RecordView(bob, &proto.RecordViewRequest{PostId: post.PostId})
+	if err != nil || repeat.Counted {
+		t.Errorf("RecordView() repeat = %v, %v, want not counted", repeat, err)
+	}
+	if _, err := server.ReadPost(alice, &proto.ReadPostRequest{PostId: post.PostId}); err != nil {
+		t.Fatalf("ReadPost() error = %v", err)
+	}
+
+	missing, err := server.RecordView(context.Background(), &proto.RecordViewRequest{PostId: post.PostId})
+	if err != nil || missing.Error == "" {
+		t.Errorf("RecordView() without viewer = %v, %v, want error in response", missing, err)
+	}
+
+	now := time.Now()
+	req := &proto.GetPostStatsRequest{
+		PostId:      post.PostId,
+		StartTime:   timestamppb.New(now.Add(-time.Hour)),
+		EndTime:     timestamppb.New(now.Add(time.Hour)),
+		Granularity: proto.StatsGranularity_STATS_GRANULARITY_HOURLY,
+	}
+
+	stats, err := server.GetPostStats(alice, req)
+	if err != nil || stats.Error != "" {
+		t.Fatalf("GetPostStats() error = %v, %s", err, stats.GetError())
+	}
+	if stats.TotalViews != 2 {
+		t.Errorf("GetPostStats() total = %d, want 2", stats.TotalViews)
+	}
+	if len(stats.Buckets) < 2 {
+		t.Errorf("GetPostStats() returned %d buckets, want at least 2", len(stats.Buckets))
+	}
+
+	if _, err := server.GetPostStats(bob, req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetPostStats() by non-owner code = %v, want PermissionDenied", status.Code(err))
+	}
+
+	tooLong := &proto.GetPostStatsRequest{
+		PostId:      post.PostId,
+		StartTime:   timestamppb.New(now.Add(-365 * 24 * time.Hour)),
+		EndTime:     timestamppb.New(now),
+		Granularity: proto.StatsGranularity_STATS_GRANULARITY_HOURLY,
+	}
+	if resp, err := server.GetPostStats(alice, tooLong); err != nil || resp.Error == "" {
+		t.Errorf("GetPostStats() over a year hourly = %v, %v, want error in response", resp, err)
+	}
+}
+
+func TestBlogServer_ViewerKey(t *testing.T) {
+	memoryStorage := storage.NewMemoryStorage()
+	server := NewBlogServer(memoryStorage, WithPolicy(authz.DefaultPolicy()))
+
+	post, err := memoryStorage.CreatePost(context.Background(), "Post", "Content", "alice", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	anonymous := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}})
+	reader := auth.NewContext(context.Background(), &auth.Identity{Subject: "bob", Roles: []string{"reader"}})
+	editor := auth.NewContext(context.Background(), &auth.Identity{Subject: "frontend", Roles: []string{"editor"}})
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		viewer      string
+		wantCounted bool
+	}{
+		{name: "anonymous", ctx: anonymous, viewer: "v1", wantCounted: true},
+		{name: "anonymous with new viewer", ctx: anonymous, viewer: "v2", wantCounted: false},
+		{name: "reader", ctx: reader, viewer: "v3", wantCounted: true},
+		{name: "reader with new viewer", ctx: reader, viewer: "v4", wantCounted: false},
+		{name: "trusted caller", ctx: editor, viewer: "v5", wantCounted: true},
+		{name: "trusted caller with new viewer", ctx: editor, viewer: "v6", wantCounted: true},
+		{name: "trusted caller repeating viewer", ctx: editor, viewer: "v6", wantCounted: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.RecordView(tt.ctx, &proto.RecordViewRequest{PostId: post.PostId, Viewer: tt.viewer})
+			if err != nil || resp.Error != "" {
+				t.Fatalf("RecordView() error = %v, %s", err, resp.GetError())
+			}
+			if resp.Counted != tt.wantCounted {
+				t.Errorf("RecordView() counted = %v, want %v", resp.Counted, tt.wantCounted)
+			}
+		})
+	}
+}
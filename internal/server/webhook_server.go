@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/validation"
+	"github.com/kpauljoseph/test/internal/webhook"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhookServer struct {
+	proto.UnimplementedWebhookServiceServer
+	dispatcher *webhook.Dispatcher
+}
+
+func NewWebhookServer(dispatcher *webhook.Dispatcher) *WebhookServer {
+	return &WebhookServer{
+		dispatcher: dispatcher,
+	}
+}
+
+func (s *WebhookServer) CreateWebhook(ctx context.Context, req *proto.CreateWebhookRequest) (*proto.CreateWebhookResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookServer.CreateWebhook")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Creating webhook", "url", req.Url, "event_types", req.EventTypes)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.CreateWebhookResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	sub, err := s.dispatcher.Subscribe(req.Url, req.EventTypes, req.Secret)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to create webhook", "url", req.Url, "error", err)
+		return &proto.CreateWebhookResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Webhook created successfully", "webhook_id", sub.ID)
+	return &proto.CreateWebhookResponse{
+		Webhook: webhookToProto(sub),
+	}, nil
+}
+
+func (s *WebhookServer) ListWebhooks(ctx context.Context, req *proto.ListWebhooksRequest) (*proto.ListWebhooksResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookServer.ListWebhooks")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing webhooks")
+
+	subs := s.dispatcher.Subscriptions()
+	webhooks := make([]*proto.Webhook, 0, len(subs))
+	for _, sub := range subs {
+		webhooks = append(webhooks, webhookToProto(sub))
+	}
+	return &proto.ListWebhooksResponse{
+		Webhooks: webhooks,
+	}, nil
+}
+
+func (s *WebhookServer) DeleteWebhook(ctx context.Context, req *proto.DeleteWebhookRequest) (*proto.DeleteWebhookResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookServer.DeleteWebhook")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Deleting webhook", "webhook_id", req.WebhookId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.DeleteWebhookResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	if err := s.dispatcher.Unsubscribe(req.WebhookId); err != nil {
+		slog.WarnContext(ctx, "Failed to delete webhook", "webhook_id", req.WebhookId, "error", err)
+		return &proto.DeleteWebhookResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Webhook deleted successfully", "webhook_id", req.WebhookId)
+	return &proto.DeleteWebhookResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *WebhookServer) ListWebhookDeliveries(ctx context.Context, req *proto.ListWebhookDeliveriesRequest) (*proto.ListWebhookDeliveriesResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookServer.ListWebhookDeliveries")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing webhook deliveries", "webhook_id", req.WebhookId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.ListWebhookDeliveriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	deliveries, err := s.dispatcher.Deliveries(req.WebhookId)
+	if err != nil {
+		return &proto.ListWebhookDeliveriesResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	return &proto.ListWebhookDeliveriesResponse{
+		Deliveries: deliveriesToProto(deliveries),
+	}, nil
+}
+
+func (s *WebhookServer) ListDeadLetters(ctx context.Context, req *proto.ListDeadLettersRequest) (*proto.ListDeadLettersResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookServer.ListDeadLetters")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Listing dead letters")
+
+	return &proto.ListDeadLettersResponse{
+		Deliveries: deliveriesToProto(s.dispatcher.DeadLetters()),
+	}, nil
+}
+
+func (s *WebhookServer) RedeliverWebhook(ctx context.Context, req *proto.RedeliverWebhookRequest) (*proto.RedeliverWebhookResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookServer.RedeliverWebhook")
+	defer span.End()
+
+	slog.InfoContext(ctx, "Redelivering webhook", "delivery_id", req.DeliveryId)
+
+	if err := validation.Validate(req); err != nil {
+		return &proto.RedeliverWebhookResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	delivery, err := s.dispatcher.Redeliver(req.DeliveryId)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to redeliver webhook", "delivery_id", req.DeliveryId, "error", err)
+		return &proto.RedeliverWebhookResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	return &proto.RedeliverWebhookResponse{
+		Delivery: deliveryToProto(delivery),
+	}, nil
+}
+
+func webhookToProto(sub webhook.Subscription) *proto.Webhook {
+	return &proto.Webhook{
+		WebhookId:  sub.ID,
+		Url:        sub.URL,
+		EventTypes: sub.EventTypes,
+		CreatedAt:  timestamppb.New(sub.CreatedAt),
+	}
+}
+
+var deliveryStates = map[webhook.DeliveryState]proto.DeliveryState{
+	webhook.StatePending:   proto.DeliveryState_DELIVERY_STATE_PENDING,
+	webhook.StateSucceeded: proto.DeliveryState_DELIVERY_STATE_SUCCEEDED,
+	webhook.StateDead:      proto.DeliveryState_DELIVERY_STATE_DEAD,
+}
+
+func deliveryToProto(d webhook.Delivery) *proto.WebhookDelivery {
+	return &proto.WebhookDelivery{
+		DeliveryId:     d.ID,
+		WebhookId:      d.WebhookID,
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		PostId:         d.PostID,
+		State:          deliveryStates[d.State],
+		Attempts:       int32(d.Attempts),
+		LastStatusCode: int32(d.LastStatusCode),
+		LastError:      d.LastError,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+		LastAttemptAt:  optionalTimestamp(d.LastAttemptAt),
+		NextAttemptAt:  optionalTimestamp(d.NextAttemptAt),
+	}
+}
+
+func deliveriesToProto(deliveries []webhook.Delivery) []*proto.WebhookDelivery {
+	result := make([]*proto.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, deliveryToProto(d))
+	}
+	return result
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/outbox"
+	"github.com/kpauljoseph/test/internal/storage"
+	"github.com/kpauljoseph/test/internal/webhook"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWebhookServer(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !webhook.Verify("secret", r.Header.Get(webhook.TimestampHeader), r.Header.Get(webhook.SignatureHeader), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var payload struct {
+			Type string `json:"type"`
+		}
+		json.Unmarshal(body, &payload)
+		mu.Lock()
+		received = append(received, payload.Type)
+		mu.Unlock()
+	}))
+	defer receiver.Close()
+
+	config := webhook.DefaultConfig()
+	config.InitialBackoffSeconds = 0.001
+	config.MaxBackoffSeconds = 0.001
+	config.AllowPrivateNetworks = true
+	dispatcher := webhook.NewDispatcher(config)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dispatcher.Run(ctx)
+
+	memoryStorage := storage.NewMemoryStorage()
+	relay := outbox.NewRelay(memoryStorage)
+	relay.AddSink("webhooks", dispatcher)
+	go relay.Run(ctx)
+
+	webhookServer := NewWebhookServer(dispatcher)
+	blogServer := NewBlogServer(memoryStorage)
+
+	invalid := []struct {
+		name string
+		req  *proto.CreateWebhookRequest
+	}{
+		{name: "missing secret", req: &proto.CreateWebhookRequest{Url: receiver.URL, EventTypes: []string{outbox.EventPostCreated}}},
+		{name: "unknown event type", req: &proto.CreateWebhookRequest{Url: receiver.URL, EventTypes: []string{"post.liked"}, Secret: "secret"}},
+		{name: "invalid url", req: &proto.CreateWebhookRequest{Url: "not a url", EventTypes: []string{outbox.EventPostCreated}, Secret: "secret"}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := webhookServer.CreateWebhook(ctx, tt.req)
+			if err != nil || resp.Error == "" {
+				t.Errorf("CreateWebhook() = %v, %v, want error in response", resp, err)
+			}
+		})
+	}
+
+	created, err := webhookServer.CreateWebhook(ctx, &proto.CreateWebhookRequest{
+		Url:        receiver.URL,
+		EventTypes: outbox.EventTypes,
+		Secret:     "secret",
+	})
+	if err != nil || created.Error != "" {
+		t.Fatalf("CreateWebhook() = %v, %v", created, err)
+	}
+	list, err := webhookServer.ListWebhooks(ctx, &proto.ListWebhooksRequest{})
+	if err != nil || len(list.Webhooks) != 1 || list.Webhooks[0].WebhookId != created.Webhook.WebhookId {
+		t.Errorf("ListWebhooks() = %v, %v", list, err)
+	}
+
+	post, _ := blogServer.CreatePost(ctx, &proto.CreatePostRequest{
+		Title:           "Hello",
+		Content:         "Content",
+		Author:          "alice",
+		PublicationDate: timestamppb.New(time.Now().Add(-time.Minute)),
+	})
+	blogServer.UpdatePost(ctx, &proto.UpdatePostRequest{PostId: post.Post.PostId, Title: "Hello again", Content: "Content"})
+	blogServer.DeletePost(ctx, &proto.DeletePostRequest{PostId: post.Post.PostId})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := webhookServer.ListWebhookDeliveries(ctx, &proto.ListWebhookDeliveriesRequest{WebhookId: created.Webhook.WebhookId})
+		if err != nil || resp.Error != "" {
+			t.Fatalf("ListWebhookDeliveries() = %v, %v", resp, err)
+		}
+		succeeded := 0
+		for _, d := range resp.Deliveries {
+			if d.State == proto.DeliveryState_DELIVERY_STATE_SUCCEEDED {
+				succeeded++
+			}
+		}
+		if succeeded == 4 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("ListWebhookDeliveries() = %v, want 4 successful deliveries", resp.Deliveries)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	mu.Lock()
+	got := map[string]bool{}
+	for _, eventType := range received {
+		got[eventType] = true
+	}
+	mu.Unlock()
+	want := map[string]bool{
+		outbox.EventPostCreated:   true,
+		outbox.EventPostPublished: true,
+		outbox.EventPostUpdated:   true,
+		outbox.EventPostDeleted:   true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("received events %v, want %v", got, want)
+	}
+
+	dead, err := webhookServer.ListDeadLetters(ctx, &proto.ListDeadLettersRequest{})
+	if err != nil || len(dead.Deliveries) != 0 {
+		t.Errorf("ListDeadLetters() = %v, %v, want none", dead, err)
+	}
+	redeliver, err := webhookServer.RedeliverWebhook(ctx, &proto.RedeliverWebhookRequest{DeliveryId: "missing"})
+	if err != nil || redeliver.Error == "" {
+		t.Errorf("RedeliverWebhook() of unknown delivery = %v, %v, want error in response", redeliver, err)
+	}
+
+	deleted, err := webhookServer.DeleteWebhook(ctx, &proto.DeleteWebhookRequest{WebhookId: created.Webhook.WebhookId})
+	if err != nil || !deleted.Success {
+		t.Errorf("DeleteWebhook() = %v, %v", deleted, err)
+	}
+	missing, err := webhookServer.ListWebhookDeliveries(ctx, &proto.ListWebhookDeliveriesRequest{WebhookId: created.Webhook.WebhookId})
+	if err != nil || missing.Error == "" {
+		t.Errorf("ListWebhookDeliveries() of deleted webhook = %v, %v, want error in response", missing, err)
+	}
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kpauljoseph/test/internal/outbox"
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// CreateAuthor stores a new author profile. It fails if a profile with the
+// same ID exists, which is also the case once the author has written a post.
+func (s *MemoryStorage) CreateAuthor(ctx context.Context, author *proto.Author) (*proto.Author, error) {
+	span, done := s.begin(ctx, "CreateAuthor", true)
+	defer done()
+	span.SetAttributes(attribute.String("author.id", author.AuthorId))
+
+	if _, exists := s.authors[author.AuthorId]; exists {
+		return nil, fmt.Errorf("author %s already exists", author.AuthorId)
+	}
+
+	stored := protobuf.Clone(author).(*proto.Author)
+	s.authors[stored.AuthorId] = stored
+	return protobuf.Clone(stored).(*proto.Author), nil
+}
+
+func (s *MemoryStorage) GetAuthor(ctx context.Context, authorID string) (*proto.Author, error) {
+	span, done := s.begin(ctx, "GetAuthor", false)
+	defer done()
+	span.SetAttributes(attribute.String("author.id", authorID))
+
+	author, exists := s.authors[authorID]
+	if !exists {
+		return nil, fmt.Errorf("author %s not found", authorID)
+	}
+	return protobuf.Clone(author).(*proto.Author), nil
+}
+
+// UpdateAuthor replaces an author profile. Posts by the author show the new
+// display name.
+func (s *MemoryStorage) UpdateAuthor(ctx context.Context, author *proto.Author) (*proto.Author, error) {
+	span, done := s.begin(ctx, "UpdateAuthor", true)
+	defer done()
+	span.SetAttributes(attribute.String("author.id", author.AuthorId))
+
+	if _, exists := s.authors[author.AuthorId]; !exists {
+		return nil, fmt.Errorf("author %s not found", author.AuthorId)
+	}
+
+	stored := protobuf.Clone(author).(*proto.Author)
+	s.authors[stored.AuthorId] = stored
+	for _, post := range s.posts {
+		if post.AuthorId == stored.AuthorId && post.Author != stored.DisplayName {
+			post.Author = stored.DisplayName
+			s.recordEvent(outbox.EventPostUpdated, post)
+		}
+	}
+	return protobuf.Clone(stored).(*proto.Author), nil
+}
+
+// ListAuthors returns every author profile ordered by display name.
+func (s *MemoryStorage) ListAuthors(ctx context.Context) []*proto.Author {
+	_, done := s.begin(ctx, "ListAuthors", false)
+	defer done()
+
+	result := make([]*proto.Author, 0, len(s.authors))
+	for _, author := range s.authors {
+		result = append(result, protobuf.Clone(author).(*proto.Author))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DisplayName == result[j].DisplayName {
+			return result[i].AuthorId < result[j].AuthorId
+		}
+		return result[i].DisplayName < result[j].DisplayName
+	})
+	return result
+}
+
+// ensureAuthor returns the profile of authorID, creating one that shows the
+// ID as display name if needed. This is how author names recorded on posts
+// become profiles. The caller must hold the write lock.
+func (s *MemoryStorage) ensureAuthor(authorID string) *proto.Author {
+	author, exists := s.authors[authorID]
+	if !exists {
+		author = &proto.Author{
+			AuthorId:    authorID,
+			DisplayName: authorID,
+		}
+		s.authors[authorID] = author
+	}
+	return author
+}
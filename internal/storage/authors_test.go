@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_Authors(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	post, _ := storage.CreatePost(ctx, "Post", "Content", "John Doe", date, nil)
+	if post.AuthorId != "John Doe" || post.Author != "John Doe" {
+		t.Errorf("CreatePost() author = %s (%s), want John Doe", post.Author, post.AuthorId)
+	}
+
+	migrated, err := storage.GetAuthor(ctx, "John Doe")
+	if err != nil {
+		t.Fatalf("GetAuthor() of post author error = %v", err)
+	}
+	if migrated.DisplayName != "John Doe" {
+		t.Errorf("GetAuthor() display name = %s, want John Doe", migrated.DisplayName)
+	}
+
+	if _, err := storage.CreateAuthor(ctx, &proto.Author{AuthorId: "John Doe", DisplayName: "John"}); err == nil {
+		t.Error("CreateAuthor() of existing author expected error")
+	}
+	if _, err := storage.CreateAuthor(ctx, &proto.Author{AuthorId: "jane", DisplayName: "Jane Smith"}); err != nil {
+		t.Fatalf("CreateAuthor() error = %v", err)
+	}
+
+	updated, err := storage.UpdateAuthor(ctx, &proto.Author{
+		AuthorId:    "John Doe",
+		DisplayName: "John D.",
+		Bio:         "Writes about Go",
+		SocialLinks: []*proto.SocialLink{{Platform: "github", Url: "https://github.com/johndoe"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateAuthor() error = %v", err)
+	}
+	if updated.Bio != "Writes about Go" || len(updated.SocialLinks) != 1 {
+		t.Errorf("UpdateAuthor() = %v", updated)
+	}
+	if _, err := storage.UpdateAuthor(ctx, &proto.Author{AuthorId: "missing", DisplayName: "x"}); err == nil {
+		t.Error("UpdateAuthor() of missing author expected error")
+	}
+
+	got, _ := storage.GetPost(ctx, post.PostId)
+	if got.Author != "John D." || got.AuthorId != "John Doe" {
+		t.Errorf("GetPost() author after rename = %s (%s), want John D. (John Doe)", got.Author, got.AuthorId)
+	}
+
+	var names []string
+	for _, a := range storage.ListAuthors(ctx) {
+		names = append(names, a.DisplayName)
+	}
+	if want := []string{"Jane Smith", "John D."}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListAuthors() = %v, want %v", names, want)
+	}
+}
+
+func TestMemoryStorage_CoAuthors(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	post, _ := storage.CreatePost(ctx, "Post", "Content", "alice", date, nil)
+	storage.CreateAuthor(ctx, &proto.Author{AuthorId: "bob", DisplayName: "Bob"})
+
+	if _, err := storage.UpdatePost(ctx, post.PostId, "Post", "Content", "alice", nil, WithCoAuthors([]string{"missing"})); err == nil {
+		t.Error("UpdatePost() with missing co-author expected error")
+	}
+	got, err := storage.UpdatePost(ctx, post.PostId, "Post", "Content", "alice", nil, WithCoAuthors([]string{"alice", "bob"}))
+	if err != nil {
+		t.Fatalf("UpdatePost() with co-authors error = %v", err)
+	}
+	if want := []string{"bob"}; !reflect.DeepEqual(got.CoAuthorIds, want) {
+		t.Errorf("UpdatePost() co-authors = %v, want %v", got.CoAuthorIds, want)
+	}
+
+	got, _ = storage.UpdatePost(ctx, post.PostId, "Post", "Content", "bob", nil)
+	if got.AuthorId != "bob" || len(got.CoAuthorIds) != 0 {
+		t.Errorf("UpdatePost() to co-author = %s %v, want bob without co-authors", got.AuthorId, got.CoAuthorIds)
+	}
+}
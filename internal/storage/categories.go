@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// CreateCategory adds a category under parentID, or at the top level when
+// parentID is empty. Sibling categories must have distinct names, ignoring
+// case.
+func (s *MemoryStorage) CreateCategory(ctx context.Context, name, parentID string) (*proto.Category, error) {
+	span, done := s.begin(ctx, "CreateCategory", true)
+	defer done()
+
+	if err := s.checkCategoryName(name, parentID, ""); err != nil {
+		return nil, err
+	}
+
+	category := &proto.Category{
+		CategoryId: uuid.New().String(),
+		Name:       name,
+		ParentId:   parentID,
+	}
+	s.categories[category.CategoryId] = category
+	span.SetAttributes(attribute.String("category.id", category.CategoryId))
+	return s.describeCategory(category, s.categoryPostCounts()), nil
+}
+
+// MoveCategory makes newParentID the parent of categoryID, or makes it a
+// top-level category when newParentID is empty. Its descendants and posts
+// move with it. A category cannot be moved below itself.
+func (s *MemoryStorage) MoveCategory(ctx context.Context, categoryID, newParentID string) (*proto.Category, error) {
+	span, done := s.begin(ctx, "MoveCategory", true)
+	defer done()
+	span.SetAttributes(attribute.String("category.id", categoryID), attribute.String("category.parent_id", newParentID))
+
+	category, exists := s.categories[categoryID]
+	if !exists {
+		return nil, fmt.Errorf("category with ID %s not found", categoryID)
+	}
+	if s.inCategory(newParentID, categoryID) {
+		return nil, fmt.Errorf("category %s cannot be moved below itself", categoryID)
+	}
+	if err := s.checkCategoryName(category.Name, newParentID, categoryID); err != nil {
+		return nil, err
+	}
+
+	category.ParentId = newParentID
+	return s.describeCategory(category, s.categoryPostCounts()), nil
+}
+
+// ListCategories returns every category depth first, with siblings ordered
+// by name.
+func (s *MemoryStorage) ListCategories(ctx context.Context) []*proto.Category {
+	_, done := s.begin(ctx, "ListCategories", false)
+	defer done()
+
+	children := make(map[string][]*proto.Category)
+	for _, c := range s.categories {
+		children[c.ParentId] = append(children[c.ParentId], c)
+	}
+
+	counts := s.categoryPostCounts()
+	result := make([]*proto.Category, 0, len(s.categories))
+	var walk func(parentID string)
+	walk = func(parentID string) {
+		siblings := children[parentID]
+		sort.Slice(siblings, func(i, j int) bool {
+			if siblings[i].Name == siblings[j].Name {
+				return siblings[i].CategoryId < siblings[j].CategoryId
+			}
+			return siblings[i].Name < siblings[j].Name
+		})
+		for _, c := range siblings {
+			result = append(result, s.describeCategory(c, counts))
+			walk(c.CategoryId)
+		}
+	}
+	walk("")
+	return result
+}
+
+// ListCategoryPosts returns the posts whose primary category is categoryID
+// or one of its descendants, newest first.
+func (s *MemoryStorage) ListCategoryPosts(ctx context.Context, categoryID string) ([]*proto.BlogPost, error) {
+	span, done := s.begin(ctx, "ListCategoryPosts", false)
+	defer done()
+	span.SetAttributes(attribute.String("category.id", categoryID))
+
+	if _, exists := s.categories[categoryID]; !exists {
+		return nil, fmt.Errorf("category with ID %s not found", categoryID)
+	}
+
+	var result []*proto.BlogPost
+	for _, post := range s.posts {
+		if post.CategoryId != "" && s.inCategory(post.CategoryId, categoryID) {
+			result = append(result, s.withReactions(post))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].PublicationDate.AsTime(), result[j].PublicationDate.AsTime()
+		if a.Equal(b) {
+			return result[i].PostId < result[j].PostId
+		}
+		return a.After(b)
+	})
+	return result, nil
+}
+
+// CategoryExists reports whether a category with the given ID exists.
+func (s *MemoryStorage) CategoryExists(ctx context.Context, categoryID string) bool {
+	_, done := s.begin(ctx, "CategoryExists", false)
+	defer done()
+
+	_, exists := s.categories[categoryID]
+	return exists
+}
+
+// checkCategoryName fails if parentID does not exist or already has a child
+// other than self named name. The caller must hold the lock.
+func (s *MemoryStorage) checkCategoryName(name, parentID, self string) error {
+	if _, exists := s.categories[parentID]; parentID != "" && !exists {
+		return fmt.Errorf("category with ID %s not found", parentID)
+	}
+	for _, c := range s.categories {
+		if c.ParentId == parentID && c.CategoryId != self && strings.EqualFold(c.Name, name) {
+			return fmt.Errorf("category %s already exists", name)
+		}
+	}
+	return nil
+}
+
+// inCategory reports whether categoryID is ancestorID or one of its
+// descendants. The caller must hold the lock.
+func (s *MemoryStorage) inCategory(categoryID, ancestorID string) bool {
+	for id := categoryID; id != ""; {
+		if id == ancestorID {
+			return true
+		}
+		c, exists := s.categories[id]
+		if !exists {
+			return false
+		}
+		id = c.ParentId
+	}
+	return false
+}
+
+// categoryPostCounts returns the number of posts in each category,
+// including those in its descendants. The caller must hold the lock.
+func (s *MemoryStorage) categoryPostCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, post := range s.posts {
+		for id := post.CategoryId; id != ""; {
+			c, exists := s.categories[id]
+			if !exists {
+				break
+			}
+			counts[id]++
+			id = c.ParentId
+		}
+	}
+	return counts
+}
+
+// describeCategory returns a copy of category with its path and post count
+// filled in. The caller must hold the lock.
+func (s *MemoryStorage) describeCategory(category *proto.Category, counts map[string]int) *proto.Category {
+	c := protobuf.Clone(category).(*proto.Category)
+	for id := category.CategoryId; id != ""; id = s.categories[id].ParentId {
+		c.Path = append([]string{s.categories[id].Name}, c.Path...)
+	}
+	c.PostCount = int32(counts[category.CategoryId])
+	return c
+}
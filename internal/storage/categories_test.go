@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_Categories(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+
+	tech, err := storage.CreateCategory(ctx, "Tech", "")
+	if err != nil {
+		t.Fatalf("CreateCategory() error = %v", err)
+	}
+	golang, _ := storage.CreateCategory(ctx, "Go", tech.CategoryId)
+	grpc, _ := storage.CreateCategory(ctx, "gRPC", golang.CategoryId)
+	life, _ := storage.CreateCategory(ctx, "Life", "")
+
+	if want := []string{"Tech", "Go", "gRPC"}; !reflect.DeepEqual(grpc.Path, want) {
+		t.Errorf("CreateCategory() path = %v, want %v", grpc.Path, want)
+	}
+
+	errTests := []struct {
+		name    string
+		call    func() error
+		wantErr string
+	}{
+		{
+			name: "duplicate sibling name",
+			call: func() error {
+				_, err := storage.CreateCategory(ctx, "go", tech.CategoryId)
+				return err
+			},
+			wantErr: "already exists",
+		},
+		{
+			name: "missing parent",
+			call: func() error {
+				_, err := storage.CreateCategory(ctx, "Rust", "missing")
+				return err
+			},
+			wantErr: "not found",
+		},
+		{
+			name: "move below itself",
+			call: func() error {
+				_, err := storage.MoveCategory(ctx, tech.CategoryId, tech.CategoryId)
+				return err
+			},
+			wantErr: "below itself",
+		},
+		{
+			name: "move below descendant",
+			call: func() error {
+				_, err := storage.MoveCategory(ctx, tech.CategoryId, grpc.CategoryId)
+				return err
+			},
+			wantErr: "below itself",
+		},
+		{
+			name: "move missing category",
+			call: func() error {
+				_, err := storage.MoveCategory(ctx, "missing", "")
+				return err
+			},
+			wantErr: "not found",
+		},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	moved, err := storage.MoveCategory(ctx, golang.CategoryId, life.CategoryId)
+	if err != nil {
+		t.Fatalf("MoveCategory() error = %v", err)
+	}
+	if want := []string{"Life", "Go"}; !reflect.DeepEqual(moved.Path, want) {
+		t.Errorf("MoveCategory() path = %v, want %v", moved.Path, want)
+	}
+
+	var got []string
+	for _, c := range storage.ListCategories(ctx) {
+		got = append(got, strings.Join(c.Path, "/"))
+	}
+	if want := []string{"Life", "Life/Go", "Life/Go/gRPC", "Tech"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListCategories() = %v, want %v", got, want)
+	}
+}
+
+func TestMemoryStorage_ListCategoryPosts(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	now := time.Now()
+
+	tech, _ := storage.CreateCategory(ctx, "Tech", "")
+	golang, _ := storage.CreateCategory(ctx, "Go", tech.CategoryId)
+	life, _ := storage.CreateCategory(ctx, "Life", "")
+
+	older, _ := storage.CreatePost(ctx, "Older", "Content", "Author", timestamppb.New(now.Add(-time.Hour)), nil, WithCategory(tech.CategoryId))
+	newer, _ := storage.CreatePost(ctx, "Newer", "Content", "Author", timestamppb.New(now), nil, WithCategory(golang.CategoryId))
+	other, _ := storage.CreatePost(ctx, "Other", "Content", "Author", timestamppb.New(now), nil)
+	storage.CreatePost(ctx, "Uncategorized", "Content", "Author", timestamppb.New(now), nil)
+
+	if _, err := storage.UpdatePost(ctx, other.PostId, "Other", "Content", "Author", nil, WithCategory(life.CategoryId)); err != nil {
+		t.Fatalf("UpdatePost() with category error = %v", err)
+	}
+	if _, err := storage.UpdatePost(ctx, other.PostId, "Other", "Content", "Author", nil, WithCategory("missing")); err == nil {
+		t.Error("UpdatePost() with missing category expected error")
+	}
+
+	tests := []struct {
+		category string
+		want     []string
+	}{
+		{category: tech.CategoryId, want: []string{newer.PostId, older.PostId}},
+		{category: golang.CategoryId, want: []string{newer.PostId}},
+		{category: life.CategoryId, want: []string{other.PostId}},
+	}
+	for _, tt := range tests {
+		posts, err := storage.ListCategoryPosts(ctx, tt.category)
+		if err != nil {
+			t.Fatalf("ListCategoryPosts() error = %v", err)
+		}
+		var got []string
+		for _, p := range posts {
+			got = append(got, p.PostId)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ListCategoryPosts(%s) = %v, want %v", tt.category, got, tt.want)
+		}
+	}
+
+	counts := make(map[string]int32)
+	for _, c := range storage.ListCategories(ctx) {
+		counts[c.Name] = c.PostCount
+	}
+	if want := map[string]int32{"Tech": 2, "Go": 1, "Life": 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("ListCategories() post counts = %v, want %v", counts, want)
+	}
+
+	if _, err := storage.ListCategoryPosts(ctx, "missing"); err == nil {
+		t.Error("ListCategoryPosts() with missing category expected error")
+	}
+}
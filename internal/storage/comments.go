@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddComment stores a comment on postID in the given moderation state. A
+// non-empty parentID makes it a reply to another approved comment on the
+// same post.
+func (s *MemoryStorage) AddComment(ctx context.Context, postID, parentID, author, content string, state proto.ModerationState, reason string) (*proto.Comment, error) {
+	span, done := s.begin(ctx, "AddComment", true)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
+
+	if _, exists := s.posts[postID]; !exists {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+	if parentID != "" {
+		parent, exists := s.comments[parentID]
+		if !exists || parent.PostId != postID || parent.ModerationState != proto.ModerationState_MODERATION_STATE_APPROVED {
+			return nil, fmt.Errorf("comment with ID %s not found on post %s", parentID, postID)
+		}
+		if parent.Deleted {
+			return nil, fmt.Errorf("comment with ID %s has been deleted", parentID)
+		}
+	}
+
+	now := timestamppb.Now()
+	comment := &proto.Comment{
+		CommentId: uuid.New().String(),
+		PostId:    postID,
+		ParentId:  parentID,
+		Author:    author,
+		Content:   content,
+		CreatedAt: now,
+		UpdatedAt: now,
+
+		ModerationState:  state,
+		ModerationReason: reason,
+	}
+
+	s.comments[comment.CommentId] = comment
+	s.recordCommentEngagement(comment, false)
+	s.commentsByPost[postID] = append(s.commentsByPost[postID], comment.CommentId)
+	return protobuf.Clone(comment).(*proto.Comment), nil
+}
+
+// GetComment returns a copy of a comment, including deleted ones.
+func (s *MemoryStorage) GetComment(ctx context.Context, commentID string) (*proto.Comment, error) {
+	_, done := s.begin(ctx, "GetComment", false)
+	defer done()
+
+	comment, exists := s.comments[commentID]
+	if !exists {
+		return nil, fmt.Errorf("comment with ID %s not found", commentID)
+	}
+	return protobuf.Clone(comment).(*proto.Comment), nil
+}
+
+// ListComments returns copies of all comments on a post, including deleted
+// ones, in the order they were added.
+func (s *MemoryStorage) ListComments(ctx context.Context, postID string) ([]*proto.Comment, error) {
+	span, done := s.begin(ctx, "ListComments", false)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
+
+	if _, exists := s.posts[postID]; !exists {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+
+	ids := s.commentsByPost[postID]
+	comments := make([]*proto.Comment, 0, len(ids))
+	for _, id := range ids {
+		comments = append(comments, protobuf.Clone(s.comments[id]).(*proto.Comment))
+	}
+	return comments, nil
+}
+
+// UpdateComment replaces the content of a comment that is not deleted. A
+// state other than MODERATION_STATE_UNSPECIFIED also replaces its
+// moderation state and reason.
+func (s *MemoryStorage) UpdateComment(ctx context.Context, commentID, content string, state proto.ModerationState, reason string) (*proto.Comment, error) {
+	_, done := s.begin(ctx, "UpdateComment", true)
+	defer done()
+
+	comment, exists := s.comments[commentID]
+	if !exists {
+		return nil, fmt.Errorf("comment with ID %s not found", commentID)
+	}
+	if comment.Deleted {
+		return nil, fmt.Errorf("comment with ID %s has been deleted", commentID)
+	}
+
+	comment.Content = content
+	comment.UpdatedAt = timestamppb.Now()
+	if state != proto.ModerationState_MODERATION_STATE_UNSPECIFIED {
+		wasVisible := commentVisible(comment)
+		comment.ModerationState = state
+		comment.ModerationReason = reason
+		comment.ModeratedBy = ""
+		s.recordCommentEngagement(comment, wasVisible)
+	}
+	return protobuf.Clone(comment).(*proto.Comment), nil
+}
+
+// DeleteComment soft-deletes a comment: it keeps its place in the thread
+// so replies stay attached, but its content is removed.
+func (s *MemoryStorage) DeleteComment(ctx context.Context, commentID string) error {
+	_, done := s.begin(ctx, "DeleteComment", true)
+	defer done()
+
+	comment, exists := s.comments[commentID]
+	if !exists || comment.Deleted {
+		return fmt.Errorf("comment with ID %s not found", commentID)
+	}
+	wasVisible := commentVisible(comment)
+	softDelete(comment)
+	s.recordCommentEngagement(comment, wasVisible)
+	return nil
+}
+
+// ModerateComment sets the moderation state of a comment on behalf of
+// moderator.
+func (s *MemoryStorage) ModerateComment(ctx context.Context, commentID string, state proto.ModerationState, reason, moderator string) (*proto.Comment, error) {
+	span, done := s.begin(ctx, "ModerateComment", true)
+	defer done()
+	span.SetAttributes(attribute.String("moderation.state", state.String()))
+
+	comment, exists := s.comments[commentID]
+	if !exists || comment.Deleted {
+		return nil, fmt.Errorf("comment with ID %s not found", commentID)
+	}
+
+	wasVisible := commentVisible(comment)
+	comment.ModerationState = state
+	comment.ModerationReason = reason
+	comment.ModeratedBy = moderator
+	s.recordCommentEngagement(comment, wasVisible)
+	return protobuf.Clone(comment).(*proto.Comment), nil
+}
+
+// ListCommentsByState returns copies of the comments in state across all
+// posts, oldest first. Deleted comments are left out.
+func (s *MemoryStorage) ListCommentsByState(ctx context.Context, state proto.ModerationState) ([]*proto.Comment, error) {
+	span, done := s.begin(ctx, "ListCommentsByState", false)
+	defer done()
+	span.SetAttributes(attribute.String("moderation.state", state.String()))
+
+	var comments []*proto.Comment
+	for _, comment := range s.comments {
+		if comment.Deleted || comment.ModerationState != state {
+			continue
+		}
+		comments = append(comments, protobuf.Clone(comment).(*proto.Comment))
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		a, b := comments[i].CreatedAt.AsTime(), comments[j].CreatedAt.AsTime()
+		if a.Equal(b) {
+			return comments[i].CommentId < comments[j].CommentId
+		}
+		return a.Before(b)
+	})
+	return comments, nil
+}
+
+// CountApprovedComments returns how many approved comments author has.
+func (s *MemoryStorage) CountApprovedComments(ctx context.Context, author string) int {
+	_, done := s.begin(ctx, "CountApprovedComments", false)
+	defer done()
+
+	count := 0
+	for _, comment := range s.comments {
+		if comment.Author == author && !comment.Deleted && comment.ModerationState == proto.ModerationState_MODERATION_STATE_APPROVED {
+			count++
+		}
+	}
+	return count
+}
+
+// deleteCommentsOfPost soft-deletes every comment on a post that is being
+// removed. The caller must hold the write lock.
+func (s *MemoryStorage) deleteCommentsOfPost(postID string) {
+	for _, id := range s.commentsByPost[postID] {
+		if comment := s.comments[id]; !comment.Deleted {
+			softDelete(comment)
+		}
+		delete(s.commentedAt, id)
+	}
+}
+
+func softDelete(comment *proto.Comment) {
+	comment.Deleted = true
+	comment.Content = ""
+	comment.UpdatedAt = timestamppb.Now()
+}
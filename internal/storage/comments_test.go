@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const approved = proto.ModerationState_MODERATION_STATE_APPROVED
+
+func TestMemoryStorage_Comments(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+
+	post, err := storage.CreatePost(ctx, "Post", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	root, err := storage.AddComment(ctx, post.PostId, "", "alice", "First", approved, "")
+	if err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+	reply, err := storage.AddComment(ctx, post.PostId, root.CommentId, "bob", "Reply", approved, "")
+	if err != nil {
+		t.Fatalf("AddComment() reply error = %v", err)
+	}
+	if reply.ParentId != root.CommentId {
+		t.Errorf("AddComment() parent = %v, want %v", reply.ParentId, root.CommentId)
+	}
+
+	if _, err := storage.AddComment(ctx, "missing", "", "alice", "x", approved, ""); err == nil {
+		t.Error("AddComment() on missing post expected error")
+	}
+	if _, err := storage.AddComment(ctx, post.PostId, "missing", "alice", "x", approved, ""); err == nil {
+		t.Error("AddComment() with missing parent expected error")
+	}
+
+	updated, err := storage.UpdateComment(ctx, root.CommentId, "Edited", proto.ModerationState_MODERATION_STATE_UNSPECIFIED, "")
+	if err != nil {
+		t.Fatalf("UpdateComment() error = %v", err)
+	}
+	if updated.Content != "Edited" {
+		t.Errorf("UpdateComment() content = %v, want Edited", updated.Content)
+	}
+
+	if err := storage.DeleteComment(ctx, root.CommentId); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+	if err := storage.DeleteComment(ctx, root.CommentId); err == nil {
+		t.Error("DeleteComment() twice expected error")
+	}
+	if _, err := storage.UpdateComment(ctx, root.CommentId, "Again", proto.ModerationState_MODERATION_STATE_UNSPECIFIED, ""); err == nil {
+		t.Error("UpdateComment() on deleted comment expected error")
+	}
+
+	comments, err := storage.ListComments(ctx, post.PostId)
+	if err != nil {
+		t.Fatalf("ListComments() error = %v", err)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("ListComments() returned %d comments, want 2", len(comments))
+	}
+	if !comments[0].Deleted || comments[0].Content != "" {
+		t.Errorf("ListComments() deleted comment = %+v, want empty placeholder", comments[0])
+	}
+}
+
+func TestMemoryStorage_DeletePostCascadesComments(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+
+	post, err := storage.CreatePost(ctx, "Post", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+	comment, err := storage.AddComment(ctx, post.PostId, "", "alice", "Hello", approved, "")
+	if err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+
+	if err := storage.DeletePost(ctx, post.PostId); err != nil {
+		t.Fatalf("DeletePost() error = %v", err)
+	}
+
+	got, err := storage.GetComment(ctx, comment.CommentId)
+	if err != nil {
+		t.Fatalf("GetComment() error = %v", err)
+	}
+	if !got.Deleted {
+		t.Error("comment should be soft-deleted with its post")
+	}
+	if _, err := storage.ListComments(ctx, post.PostId); err == nil {
+		t.Error("ListComments() on deleted post expected error")
+	}
+}
+
+func TestMemoryStorage_ModerateComment(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+
+	post, err := storage.CreatePost(ctx, "Post", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	pending, err := storage.AddComment(ctx, post.PostId, "", "alice", "Held", proto.ModerationState_MODERATION_STATE_PENDING, "new commenter")
+	if err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+	if _, err := storage.AddComment(ctx, post.PostId, pending.CommentId, "bob", "Reply", approved, ""); err == nil {
+		t.Error("AddComment() reply to pending comment expected error")
+	}
+	if _, err := storage.AddComment(ctx, post.PostId, "", "bob", "Fine", approved, ""); err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+
+	queue, err := storage.ListCommentsByState(ctx, proto.ModerationState_MODERATION_STATE_PENDING)
+	if err != nil {
+		t.Fatalf("ListCommentsByState() error = %v", err)
+	}
+	if len(queue) != 1 || queue[0].CommentId != pending.CommentId {
+		t.Fatalf("ListCommentsByState() = %v, want only %s", queue, pending.CommentId)
+	}
+	if got := storage.CountApprovedComments(ctx, "alice"); got != 0 {
+		t.Errorf("CountApprovedComments() = %d, want 0", got)
+	}
+
+	moderated, err := storage.ModerateComment(ctx, pending.CommentId, approved, "looks fine", "carol")
+	if err != nil {
+		t.Fatalf("ModerateComment() error = %v", err)
+	}
+	if moderated.ModerationState != approved || moderated.ModeratedBy != "carol" {
+		t.Errorf("ModerateComment() = %+v, want approved by carol", moderated)
+	}
+	if got := storage.CountApprovedComments(ctx, "alice"); got != 1 {
+		t.Errorf("CountApprovedComments() = %d, want 1", got)
+	}
+	if _, err := storage.ModerateComment(ctx, "missing", approved, "", "carol"); err == nil {
+		t.Error("ModerateComment() on missing comment expected error")
+	}
+}
@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kpauljoseph/test/internal/dedup"
+)
+
+// DefaultDuplicateThreshold is the similarity at which posts count as near
+// duplicates unless WithDuplicateThreshold is used.
+const DefaultDuplicateThreshold = 0.8
+
+// WithDuplicateThreshold sets the content similarity, between 0 and 1, at
+// which posts count as near duplicates.
+func WithDuplicateThreshold(threshold float64) Option {
+	return func(s *MemoryStorage) {
+		s.duplicateThreshold = threshold
+	}
+}
+
+// DuplicateCheck compares the content of a new post with the stored posts
+// in the write that creates it, so that concurrent creates of the same
+// content cannot both pass the check.
+type DuplicateCheck struct {
+	// Reject makes CreatePost fail with a *DuplicateError instead of
+	// storing a post that has near duplicates.
+	Reject bool
+	// Matches receives the near duplicates, most similar first.
+	Matches []dedup.Match
+}
+
+// WithDuplicateCheck runs check when CreatePost stores the post. A nil
+// check is ignored.
+func WithDuplicateCheck(check *DuplicateCheck) PostOption {
+	return func(f *postFields) {
+		f.duplicateCheck = check
+	}
+}
+
+// DuplicateError is returned by CreatePost when a DuplicateCheck rejects
+// the post.
+type DuplicateError struct {
+	// Matches are the near duplicates, most similar first.
+	Matches []dedup.Match
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("content is a near duplicate of post %s (%.0f%% similar)", e.Matches[0].PostID, e.Matches[0].Similarity*100)
+}
+
+// checkDuplicates runs the duplicate check of f against sig. The caller
+// must hold the write lock.
+func (s *MemoryStorage) checkDuplicates(f *postFields, sig dedup.Signature) error {
+	check := f.duplicateCheck
+	if check == nil {
+		return nil
+	}
+	check.Matches = s.duplicates.Similar(sig, s.duplicateThreshold, "")
+	if check.Reject && len(check.Matches) > 0 {
+		return &DuplicateError{Matches: check.Matches}
+	}
+	return nil
+}
+
+// DuplicatePairs returns every pair of stored posts whose similarity is at
+// least threshold, or the configured threshold when it is zero. The posts
+// are compared on a copy of the index, after the lock is released, so that
+// writes are not held up by the comparison.
+func (s *MemoryStorage) DuplicatePairs(ctx context.Context, threshold float64) []dedup.Pair {
+	_, done := s.begin(ctx, "DuplicatePairs", false)
+	if threshold <= 0 {
+		threshold = s.duplicateThreshold
+	}
+	index := s.duplicates.Clone()
+	done()
+
+	return index.Pairs(threshold)
+}
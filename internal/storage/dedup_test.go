@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_DuplicateIndexFollowsPosts(t *testing.T) {
+	storage := NewMemoryStorage(WithDuplicateThreshold(0.9))
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+	// similar returns the posts a rejecting check finds for content.
+	similar := func(content string) []string {
+		check := &DuplicateCheck{Reject: true}
+		if post, err := storage.CreatePost(ctx, "Probe", content, "alice", date, nil, WithDuplicateCheck(check)); err == nil {
+			storage.DeletePost(ctx, post.PostId)
+		}
+		var ids []string
+		for _, m := range check.Matches {
+			ids = append(ids, m.PostID)
+		}
+		return ids
+	}
+
+	content := "Table driven tests keep Go test files short and make new cases cheap to add"
+	post, _ := storage.CreatePost(ctx, "Tests", content, "alice", date, nil)
+
+	if got := similar(content); len(got) != 1 || got[0] != post.PostId {
+		t.Errorf("similar posts = %v, want %s", got, post.PostId)
+	}
+
+	if _, err := storage.UpdatePost(ctx, post.PostId, "Tests", "Completely rewritten text about fuzzing", "alice", nil); err != nil {
+		t.Fatalf("UpdatePost() error = %v", err)
+	}
+	if got := similar(content); len(got) != 0 {
+		t.Errorf("similar posts after update = %v, want none", got)
+	}
+
+	if err := storage.DeletePost(ctx, post.PostId); err != nil {
+		t.Fatalf("DeletePost() error = %v", err)
+	}
+	if got := similar("Completely rewritten text about fuzzing"); len(got) != 0 {
+		t.Errorf("similar posts after delete = %v, want none", got)
+	}
+}
+
+func TestMemoryStorage_DuplicateCheck(t *testing.T) {
+	storage := NewMemoryStorage(WithDuplicateThreshold(0.9))
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+	content := "Table driven tests keep Go test files short and make new cases cheap to add"
+
+	// Concurrent creates of the same content must not both pass a
+	// rejecting check.
+	const creates = 10
+	var wg sync.WaitGroup
+	var created atomic.Int32
+	for range creates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := storage.CreatePost(ctx, "Tests", content, "alice", date, nil, WithDuplicateCheck(&DuplicateCheck{Reject: true}))
+			var dup *DuplicateError
+			switch {
+			case err == nil:
+				created.Add(1)
+			case !errors.As(err, &dup):
+				t.Errorf("CreatePost() error = %v, want *DuplicateError", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := created.Load(); got != 1 {
+		t.Errorf("CreatePost() stored %d posts with a rejecting check, want 1", got)
+	}
+
+	check := &DuplicateCheck{}
+	if _, err := storage.CreatePost(ctx, "Tests", content, "alice", date, nil, WithDuplicateCheck(check)); err != nil {
+		t.Fatalf("CreatePost() with a warning check error = %v", err)
+	}
+	if len(check.Matches) != 1 {
+		t.Errorf("CreatePost() check matches = %v, want 1", check.Matches)
+	}
+}
@@ -0,0 +1,225 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/feed"
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// following holds the authors and tags a user follows.
+type following struct {
+	authors map[string]struct{}
+	tags    map[string]struct{}
+}
+
+// Follow subscribes user to the posts of authorID or, when authorID is
+// empty, to posts tagged with tag. Following a source twice has no effect.
+func (s *MemoryStorage) Follow(ctx context.Context, user, authorID, tag string) error {
+	span, done := s.begin(ctx, "Follow", true)
+	defer done()
+	span.SetAttributes(attribute.String("user", user), attribute.String("author.id", authorID), attribute.String("tag", tag))
+
+	if _, exists := s.authors[authorID]; authorID != "" && !exists {
+		return fmt.Errorf("author %s not found", authorID)
+	}
+
+	f, ok := s.follows[user]
+	if !ok {
+		f = &following{authors: make(map[string]struct{}), tags: make(map[string]struct{})}
+		s.follows[user] = f
+	}
+	if authorID != "" {
+		f.authors[authorID] = struct{}{}
+	} else {
+		f.tags[tag] = struct{}{}
+	}
+	return nil
+}
+
+// Unfollow removes a subscription added by Follow. Unfollowing a source
+// that is not followed has no effect.
+func (s *MemoryStorage) Unfollow(ctx context.Context, user, authorID, tag string) {
+	span, done := s.begin(ctx, "Unfollow", true)
+	defer done()
+	span.SetAttributes(attribute.String("user", user), attribute.String("author.id", authorID), attribute.String("tag", tag))
+
+	f, ok := s.follows[user]
+	if !ok {
+		return
+	}
+	if authorID != "" {
+		delete(f.authors, authorID)
+	} else {
+		delete(f.tags, tag)
+	}
+	if len(f.authors) == 0 && len(f.tags) == 0 {
+		delete(s.follows, user)
+	}
+}
+
+// moveFollowedTags makes the followers of any of sources follow target
+// instead, and returns the users who follow sources or target, sorted. The
+// caller must hold the write lock.
+func (s *MemoryStorage) moveFollowedTags(sources []string, target string) []string {
+	var users []string
+	for user, f := range s.follows {
+		_, affected := f.tags[target]
+		for _, tag := range sources {
+			if _, followed := f.tags[tag]; followed {
+				delete(f.tags, tag)
+				f.tags[target] = struct{}{}
+				affected = true
+			}
+		}
+		if affected {
+			users = append(users, user)
+		}
+	}
+	sort.Strings(users)
+	return users
+}
+
+// Following returns the authors and tags user follows, sorted.
+func (s *MemoryStorage) Following(ctx context.Context, user string) (authors, tags []string) {
+	_, done := s.begin(ctx, "Following", false)
+	defer done()
+
+	f, ok := s.follows[user]
+	if !ok {
+		return nil, nil
+	}
+	for id := range f.authors {
+		authors = append(authors, id)
+	}
+	for tag := range f.tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(authors)
+	sort.Strings(tags)
+	return authors, tags
+}
+
+// FeedSources returns, for each author and tag user follows, its newest
+// posts published by now, at most perSource of them and newest first.
+// Co-authored posts count towards each of their authors. Only the newest
+// posts of each followed source are read, however many posts it has.
+func (s *MemoryStorage) FeedSources(ctx context.Context, user string, perSource int, now time.Time) [][]feed.Entry {
+	span, done := s.begin(ctx, "FeedSources", false)
+	defer done()
+	span.SetAttributes(attribute.String("user", user))
+
+	f, ok := s.follows[user]
+	if !ok {
+		return nil
+	}
+
+	sources := make([][]feed.Entry, 0, len(f.authors)+len(f.tags))
+	for id := range f.authors {
+		if entries := s.postsByAuthor.newest(id, perSource, now); len(entries) > 0 {
+			sources = append(sources, entries)
+		}
+	}
+	for tag := range f.tags {
+		if entries := s.postsByTag.newest(tag, perSource, now); len(entries) > 0 {
+			sources = append(sources, entries)
+		}
+	}
+	return sources
+}
+
+// postIndex lists the posts of each author or tag newest first, so that
+// feeds read only the newest posts of the sources they follow.
+type postIndex map[string][]feed.Entry
+
+// add inserts e under key, keeping the list ordered.
+func (x postIndex) add(key string, e feed.Entry) {
+	entries := x[key]
+	i, _ := slices.BinarySearchFunc(entries, e, compareEntries)
+	x[key] = slices.Insert(entries, i, e)
+}
+
+// remove drops e from under key.
+func (x postIndex) remove(key string, e feed.Entry) {
+	entries := x[key]
+	i, found := slices.BinarySearchFunc(entries, e, compareEntries)
+	if !found {
+		return
+	}
+	if entries = slices.Delete(entries, i, i+1); len(entries) == 0 {
+		delete(x, key)
+	} else {
+		x[key] = entries
+	}
+}
+
+// newest returns up to limit entries under key published by now, newest
+// first. Scheduled posts sort first and are skipped.
+func (x postIndex) newest(key string, limit int, now time.Time) []feed.Entry {
+	entries := x[key]
+	start := sort.Search(len(entries), func(i int) bool { return !entries[i].Published.After(now) })
+	end := min(start+limit, len(entries))
+	return slices.Clone(entries[start:end])
+}
+
+func compareEntries(a, b feed.Entry) int {
+	switch {
+	case a.Newer(b):
+		return -1
+	case b.Newer(a):
+		return 1
+	}
+	return 0
+}
+
+// indexPost adds post to the author and tag indexes read by feeds. It must
+// be called after every change to the authors or tags of a post, with
+// unindexPost called before it. The caller must hold the write lock.
+func (s *MemoryStorage) indexPost(post *proto.BlogPost) {
+	e := feed.Entry{PostID: post.PostId, Published: post.PublicationDate.AsTime()}
+	for _, id := range uniqueKeys(append([]string{post.AuthorId}, post.CoAuthorIds...)) {
+		s.postsByAuthor.add(id, e)
+	}
+	for _, tag := range uniqueKeys(post.Tags) {
+		s.postsByTag.add(tag, e)
+	}
+}
+
+// unindexPost removes post from the indexes indexPost added it to. The
+// caller must hold the write lock.
+func (s *MemoryStorage) unindexPost(post *proto.BlogPost) {
+	e := feed.Entry{PostID: post.PostId, Published: post.PublicationDate.AsTime()}
+	for _, id := range uniqueKeys(append([]string{post.AuthorId}, post.CoAuthorIds...)) {
+		s.postsByAuthor.remove(id, e)
+	}
+	for _, tag := range uniqueKeys(post.Tags) {
+		s.postsByTag.remove(tag, e)
+	}
+}
+
+// uniqueKeys returns keys sorted and without duplicates.
+func uniqueKeys(keys []string) []string {
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// GetPosts returns the posts with the given IDs in the same order, skipping
+// posts that no longer exist.
+func (s *MemoryStorage) GetPosts(ctx context.Context, postIDs []string) []*proto.BlogPost {
+	_, done := s.begin(ctx, "GetPosts", false)
+	defer done()
+
+	result := make([]*proto.BlogPost, 0, len(postIDs))
+	for _, id := range postIDs {
+		if post, exists := s.posts[id]; exists {
+			result = append(result, s.withReactions(post))
+		}
+	}
+	return result
+}
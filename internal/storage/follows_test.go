@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/feed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_Follows(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) *timestamppb.Timestamp {
+		return timestamppb.New(base.Add(time.Duration(hours) * time.Hour))
+	}
+
+	old, _ := storage.CreatePost(ctx, "Old", "Content", "alice", at(1), []string{"go"})
+	mid, _ := storage.CreatePost(ctx, "Mid", "Content", "alice", at(2), nil)
+	tagged, _ := storage.CreatePost(ctx, "Tagged", "Content", "bob", at(3), []string{"go"})
+	storage.CreatePost(ctx, "Unfollowed", "Content", "bob", at(4), []string{"rust"})
+	scheduled, _ := storage.CreatePost(ctx, "Scheduled", "Content", "alice", at(6), nil)
+
+	if err := storage.Follow(ctx, "reader", "missing", ""); err == nil {
+		t.Error("Follow() of missing author expected error")
+	}
+	storage.Follow(ctx, "reader", "alice", "")
+	storage.Follow(ctx, "reader", "", "go")
+	storage.Follow(ctx, "reader", "", "go")
+
+	authors, tags := storage.Following(ctx, "reader")
+	if !reflect.DeepEqual(authors, []string{"alice"}) || !reflect.DeepEqual(tags, []string{"go"}) {
+		t.Errorf("Following() = %v, %v, want [alice], [go]", authors, tags)
+	}
+
+	ids := func(entries []feed.Entry) []string {
+		var result []string
+		for _, e := range entries {
+			result = append(result, e.PostID)
+		}
+		return result
+	}
+
+	got := ids(feed.Merge(storage.FeedSources(ctx, "reader", 10, at(5).AsTime()), 10))
+	if want := []string{tagged.PostId, mid.PostId, old.PostId}; !reflect.DeepEqual(got, want) {
+		t.Errorf("feed = %v, want %v", got, want)
+	}
+
+	got = ids(feed.Merge(storage.FeedSources(ctx, "reader", 10, at(6).AsTime()), 10))
+	if want := []string{scheduled.PostId, tagged.PostId, mid.PostId, old.PostId}; !reflect.DeepEqual(got, want) {
+		t.Errorf("feed once the scheduled post is published = %v, want %v", got, want)
+	}
+
+	for _, source := range storage.FeedSources(ctx, "reader", 1, at(5).AsTime()) {
+		if len(source) != 1 {
+			t.Errorf("FeedSources() with perSource 1 returned %d entries", len(source))
+		}
+	}
+
+	storage.Unfollow(ctx, "reader", "", "go")
+	got = ids(feed.Merge(storage.FeedSources(ctx, "reader", 10, at(5).AsTime()), 10))
+	if want := []string{mid.PostId, old.PostId}; !reflect.DeepEqual(got, want) {
+		t.Errorf("feed after Unfollow() = %v, want %v", got, want)
+	}
+
+	storage.Unfollow(ctx, "reader", "alice", "")
+	if sources := storage.FeedSources(ctx, "reader", 10, at(5).AsTime()); len(sources) != 0 {
+		t.Errorf("FeedSources() after unfollowing everything = %v, want none", sources)
+	}
+
+	storage.DeletePost(ctx, mid.PostId)
+	posts := storage.GetPosts(ctx, []string{tagged.PostId, mid.PostId, old.PostId})
+	if len(posts) != 2 || posts[0].PostId != tagged.PostId || posts[1].PostId != old.PostId {
+		t.Errorf("GetPosts() = %v, want the two remaining posts in order", posts)
+	}
+}
+
+func TestMemoryStorage_FeedSourcesFollowPostChanges(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	now := time.Now()
+	feedOf := func(user string) []string {
+		var result []string
+		for _, e := range feed.Merge(storage.FeedSources(ctx, user, 10, now), 10) {
+			result = append(result, e.PostID)
+		}
+		return result
+	}
+
+	first, _ := storage.CreatePost(ctx, "First", "Content", "alice", timestamppb.New(now.Add(-2*time.Hour)), []string{"go"})
+	second, _ := storage.CreatePost(ctx, "Second", "Content", "bob", timestamppb.New(now.Add(-time.Hour)), []string{"rust"}, WithCoAuthors([]string{"alice"}))
+	storage.Follow(ctx, "reader", "alice", "")
+	storage.Follow(ctx, "reader", "", "web")
+	if got, want := feedOf("reader"), []string{second.PostId, first.PostId}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("feed = %v, want %v", got, want)
+	}
+
+	storage.UpdatePost(ctx, first.PostId, "First", "Content", "bob", []string{"web"})
+	storage.UpdatePost(ctx, second.PostId, "Second", "Content", "bob", []string{"rust"}, WithCoAuthors(nil))
+	if got, want := feedOf("reader"), []string{first.PostId}; !reflect.DeepEqual(got, want) {
+		t.Errorf("feed after changing authors and tags = %v, want %v", got, want)
+	}
+
+	storage.MergeTags(ctx, []string{"rust"}, "web")
+	if got, want := feedOf("reader"), []string{second.PostId, first.PostId}; !reflect.DeepEqual(got, want) {
+		t.Errorf("feed after merging tags = %v, want %v", got, want)
+	}
+
+	storage.DeletePost(ctx, second.PostId)
+	if got, want := feedOf("reader"), []string{first.PostId}; !reflect.DeepEqual(got, want) {
+		t.Errorf("feed after deleting a post = %v, want %v", got, want)
+	}
+}
@@ -1,80 +1,344 @@
 package storage
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/kpauljoseph/test/internal/dedup"
+	"github.com/kpauljoseph/test/internal/outbox"
+	"github.com/kpauljoseph/test/internal/related"
+	"github.com/kpauljoseph/test/internal/tagging"
+	"github.com/kpauljoseph/test/internal/trending"
 	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var tracer = otel.Tracer("github.com/kpauljoseph/test/internal/storage")
+
 type MemoryStorage struct {
 	mu    sync.RWMutex
 	posts map[string]*proto.BlogPost
+
+	comments       map[string]*proto.Comment
+	commentsByPost map[string][]string
+	// commentedAt records when each visible comment was counted
+	// towards its post's trending engagement.
+	commentedAt map[string]time.Time
+
+	reactions map[string]reactionSet
+
+	// views holds hourly view counts per post, keyed by the Unix time at
+	// the start of the hour. lastViews records when each post and viewer
+	// pair was last counted.
+	views         map[string]map[int64]int64
+	lastViews     map[string]time.Time
+	lastViewSweep time.Time
+
+	trending *trending.Tracker
+	related  *related.Index
+
+	duplicates         *dedup.Index
+	duplicateThreshold float64
+
+	tagTrie *tagging.Trie
+
+	categories map[string]*proto.Category
+
+	series       map[string]*proto.Series
+	seriesOfPost map[string]string
+
+	authors map[string]*proto.Author
+
+	follows map[string]*following
+	// postsByAuthor and postsByTag index posts for FeedSources.
+	postsByAuthor postIndex
+	postsByTag    postIndex
+
+	// outbox holds the events after sequence outboxTrimmed, which every
+	// consumer has acknowledged up to. outboxCursors is the last sequence
+	// each consumer acknowledged.
+	outbox         []outbox.Event
+	outboxSequence uint64
+	outboxTrimmed  uint64
+	outboxCursors  map[string]uint64
+	outboxReady    chan struct{}
+	// scheduled holds the publication dates of posts whose publication
+	// has not been recorded yet.
+	scheduled map[string]time.Time
+}
+
+// Option configures optional MemoryStorage behaviour.
+type Option func(*MemoryStorage)
+
+// WithTrending sets how engagement is weighted and windowed for
+// TrendingPosts. trending.DefaultConfig is used otherwise.
+func WithTrending(config *trending.Config) Option {
+	return func(s *MemoryStorage) {
+		s.trending = trending.NewTracker(config)
+	}
+}
+
+func NewMemoryStorage(opts ...Option) *MemoryStorage {
+	s := &MemoryStorage{
+		posts:          make(map[string]*proto.BlogPost),
+		comments:       make(map[string]*proto.Comment),
+		commentsByPost: make(map[string][]string),
+		commentedAt:    make(map[string]time.Time),
+		reactions:      make(map[string]reactionSet),
+		views:          make(map[string]map[int64]int64),
+		lastViews:      make(map[string]time.Time),
+		trending:       trending.NewTracker(trending.DefaultConfig()),
+		related:        related.NewIndex(related.DefaultWeights),
+
+		duplicates:         dedup.NewIndex(),
+		duplicateThreshold: DefaultDuplicateThreshold,
+
+		tagTrie: tagging.NewTrie(),
+
+		categories: make(map[string]*proto.Category),
+
+		series:       make(map[string]*proto.Series),
+		seriesOfPost: make(map[string]string),
+
+		authors: make(map[string]*proto.Author),
+
+		follows:       make(map[string]*following),
+		postsByAuthor: make(postIndex),
+		postsByTag:    make(postIndex),
+
+		outboxCursors: make(map[string]uint64),
+		outboxReady:   make(chan struct{}, 1),
+		scheduled:     make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// begin starts a span for a storage operation and acquires the lock,
+// recording how long the operation waited for it. The returned function
+// releases the lock and ends the span.
+func (s *MemoryStorage) begin(ctx context.Context, op string, write bool) (trace.Span, func()) {
+	_, span := tracer.Start(ctx, "MemoryStorage."+op)
+
+	start := time.Now()
+	unlock := s.mu.RUnlock
+	if write {
+		s.mu.Lock()
+		unlock = s.mu.Unlock
+	} else {
+		s.mu.RLock()
+	}
+	span.SetAttributes(attribute.Int64("storage.lock_wait_us", time.Since(start).Microseconds()))
+
+	return span, func() {
+		unlock()
+		span.End()
+	}
 }
 
-func NewMemoryStorage() *MemoryStorage {
-	return &MemoryStorage{
-		posts: make(map[string]*proto.BlogPost),
+// PostOption sets an optional field of a post in the same write that
+// creates or updates it.
+type PostOption func(*postFields)
+
+type postFields struct {
+	setCategory  bool
+	categoryID   string
+	setCoAuthors bool
+	coAuthorIDs  []string
+
+	duplicateCheck *DuplicateCheck
+}
+
+// WithCategory makes categoryID the primary category of the post, or
+// removes its category when empty.
+func WithCategory(categoryID string) PostOption {
+	return func(f *postFields) {
+		f.setCategory = true
+		f.categoryID = categoryID
 	}
 }
 
-func (s *MemoryStorage) CreatePost(title, content, author string, publicationDate *timestamppb.Timestamp, tags []string) (*proto.BlogPost, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// WithCoAuthors replaces the co-authors of the post. Every co-author must
+// have a profile; the primary author is left out.
+func WithCoAuthors(authorIDs []string) PostOption {
+	return func(f *postFields) {
+		f.setCoAuthors = true
+		f.coAuthorIDs = authorIDs
+	}
+}
+
+func newPostFields(opts []PostOption) *postFields {
+	f := &postFields{}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// checkPostFields fails if f refers to a category or co-author that does
+// not exist. authorID is the primary author, who is never a co-author. The
+// caller must hold the lock.
+func (s *MemoryStorage) checkPostFields(f *postFields, authorID string) error {
+	if _, exists := s.categories[f.categoryID]; f.setCategory && f.categoryID != "" && !exists {
+		return fmt.Errorf("category with ID %s not found", f.categoryID)
+	}
+	for _, id := range f.coAuthorIDs {
+		if _, exists := s.authors[id]; !exists && id != authorID {
+			return fmt.Errorf("author %s not found", id)
+		}
+	}
+	return nil
+}
+
+// apply sets the fields of f on post, whose author must already be set.
+func (f *postFields) apply(post *proto.BlogPost) {
+	if f.setCategory {
+		post.CategoryId = f.categoryID
+	}
+	if f.setCoAuthors {
+		post.CoAuthorIds = slices.DeleteFunc(slices.Clone(f.coAuthorIDs), func(id string) bool { return id == post.AuthorId })
+	}
+}
+
+// CreatePost stores a new post by the author with ID authorID, creating a
+// profile for the author if there is none. It records the creation of the
+// post in the outbox, and its publication unless it is scheduled for later.
+func (s *MemoryStorage) CreatePost(ctx context.Context, title, content, authorID string, publicationDate *timestamppb.Timestamp, tags []string, opts ...PostOption) (*proto.BlogPost, error) {
+	sig := dedup.Fingerprint(content)
+
+	span, done := s.begin(ctx, "CreatePost", true)
+	defer done()
+
+	fields := newPostFields(opts)
+	if err := s.checkPostFields(fields, authorID); err != nil {
+		return nil, err
+	}
+	if err := s.checkDuplicates(fields, sig); err != nil {
+		return nil, err
+	}
 
 	post := &proto.BlogPost{
 		PostId:          uuid.New().String(),
 		Title:           title,
 		Content:         content,
-		Author:          author,
+		Author:          s.ensureAuthor(authorID).DisplayName,
+		AuthorId:        authorID,
 		PublicationDate: publicationDate,
 		Tags:            tags,
 	}
+	fields.apply(post)
 
 	s.posts[post.PostId] = post
-	return post, nil
+	s.indexPost(post)
+	s.retag(nil, tags)
+	s.related.Put(post.PostId, authorID, content, tags)
+	s.duplicates.Put(post.PostId, sig)
+	s.recordEvent(outbox.EventPostCreated, post)
+	s.recordPublication(post, time.Now())
+	span.SetAttributes(attribute.String("post.id", post.PostId))
+	return s.withReactions(post), nil
 }
 
-func (s *MemoryStorage) GetPost(postID string) (*proto.BlogPost, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *MemoryStorage) GetPost(ctx context.Context, postID string) (*proto.BlogPost, error) {
+	span, done := s.begin(ctx, "GetPost", false)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
 
 	post, exists := s.posts[postID]
 	if !exists {
 		return nil, fmt.Errorf("post with ID %s not found", postID)
 	}
 
-	return post, nil
+	return s.withReactions(post), nil
 }
 
-func (s *MemoryStorage) UpdatePost(postID, title, content, author string, tags []string) (*proto.BlogPost, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// UpdatePost replaces the content of a post and makes authorID its primary
+// author, creating a profile for the author if there is none.
+func (s *MemoryStorage) UpdatePost(ctx context.Context, postID, title, content, authorID string, tags []string, opts ...PostOption) (*proto.BlogPost, error) {
+	span, done := s.begin(ctx, "UpdatePost", true)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
 
 	post, exists := s.posts[postID]
 	if !exists {
 		return nil, fmt.Errorf("post with ID %s not found", postID)
 	}
+	fields := newPostFields(opts)
+	if err := s.checkPostFields(fields, authorID); err != nil {
+		return nil, err
+	}
 
+	s.unindexPost(post)
+	s.retag(post.Tags, tags)
 	post.Title = title
 	post.Content = content
-	post.Author = author
+	post.Author = s.ensureAuthor(authorID).DisplayName
+	post.AuthorId = authorID
+	post.CoAuthorIds = slices.DeleteFunc(post.CoAuthorIds, func(id string) bool { return id == authorID })
 	post.Tags = tags
+	fields.apply(post)
+	s.indexPost(post)
+	s.related.Put(postID, authorID, content, tags)
+	s.duplicates.Put(postID, dedup.Fingerprint(content))
+	s.recordEvent(outbox.EventPostUpdated, post)
 
-	return post, nil
+	return s.withReactions(post), nil
 }
 
-func (s *MemoryStorage) DeletePost(postID string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *MemoryStorage) DeletePost(ctx context.Context, postID string) error {
+	span, done := s.begin(ctx, "DeletePost", true)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
 
-	_, exists := s.posts[postID]
+	post, exists := s.posts[postID]
 	if !exists {
 		return fmt.Errorf("post with ID %s not found", postID)
 	}
 
+	s.unindexPost(post)
+	s.retag(post.Tags, nil)
 	delete(s.posts, postID)
+	delete(s.reactions, postID)
+	delete(s.views, postID)
+	s.trending.Remove(postID)
+	s.related.Remove(postID)
+	s.duplicates.Remove(postID)
+	s.deleteCommentsOfPost(postID)
+	s.removeFromSeries(postID)
+	delete(s.scheduled, postID)
+	s.appendEvent(outbox.NewDeletedEvent(postID, time.Now()))
 	return nil
 }
+
+// Stats summarises the contents of the storage.
+type Stats struct {
+	Posts int
+	Tags  int
+}
+
+// Stats returns the number of stored posts and of distinct tags in use.
+func (s *MemoryStorage) Stats(ctx context.Context) Stats {
+	_, done := s.begin(ctx, "Stats", false)
+	defer done()
+
+	tags := make(map[string]struct{})
+	for _, post := range s.posts {
+		for _, tag := range post.Tags {
+			tags[tag] = struct{}{}
+		}
+	}
+
+	return Stats{
+		Posts: len(s.posts),
+		Tags:  len(tags),
+	}
+}
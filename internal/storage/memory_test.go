@@ -1,14 +1,20 @@
 package storage
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestMemoryStorage_CreatePost(t *testing.T) {
 	storage := NewMemoryStorage()
+	ctx := context.Background()
 
 	tests := []struct {
 		name    string
@@ -36,7 +42,7 @@ func TestMemoryStorage_CreatePost(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			pubDate := timestamppb.New(time.Now())
-			post, err := storage.CreatePost(tt.title, tt.content, tt.author, pubDate, tt.tags)
+			post, err := storage.CreatePost(ctx, tt.title, tt.content, tt.author, pubDate, tt.tags)
 			if err != nil {
 				t.Errorf("CreatePost() error = %v", err)
 				return
@@ -66,8 +72,9 @@ func TestMemoryStorage_CreatePost(t *testing.T) {
 
 func TestMemoryStorage_GetPost(t *testing.T) {
 	storage := NewMemoryStorage()
+	ctx := context.Background()
 
-	post, err := storage.CreatePost("Test", "Content", "Author", timestamppb.New(time.Now()), []string{"tag"})
+	post, err := storage.CreatePost(ctx, "Test", "Content", "Author", timestamppb.New(time.Now()), []string{"tag"})
 	if err != nil {
 		t.Fatalf("Failed to create test post: %v", err)
 	}
@@ -91,7 +98,7 @@ func TestMemoryStorage_GetPost(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := storage.GetPost(tt.postID)
+			got, err := storage.GetPost(ctx, tt.postID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetPost() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -105,8 +112,9 @@ func TestMemoryStorage_GetPost(t *testing.T) {
 
 func TestMemoryStorage_UpdatePost(t *testing.T) {
 	storage := NewMemoryStorage()
+	ctx := context.Background()
 
-	post, err := storage.CreatePost("Original", "Original Content", "Original Author", timestamppb.New(time.Now()), []string{"original"})
+	post, err := storage.CreatePost(ctx, "Original", "Original Content", "Original Author", timestamppb.New(time.Now()), []string{"original"})
 	if err != nil {
 		t.Fatalf("Failed to create test post: %v", err)
 	}
@@ -146,7 +154,7 @@ func TestMemoryStorage_UpdatePost(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := storage.UpdatePost(tt.postID, tt.title, tt.content, tt.author, tt.tags)
+			got, err := storage.UpdatePost(ctx, tt.postID, tt.title, tt.content, tt.author, tt.tags)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UpdatePost() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -165,8 +173,9 @@ func TestMemoryStorage_UpdatePost(t *testing.T) {
 
 func TestMemoryStorage_DeletePost(t *testing.T) {
 	storage := NewMemoryStorage()
+	ctx := context.Background()
 
-	post, err := storage.CreatePost("Test", "Content", "Author", timestamppb.New(time.Now()), []string{"tag"})
+	post, err := storage.CreatePost(ctx, "Test", "Content", "Author", timestamppb.New(time.Now()), []string{"tag"})
 	if err != nil {
 		t.Fatalf("Failed to create test post: %v", err)
 	}
@@ -190,13 +199,13 @@ func TestMemoryStorage_DeletePost(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := storage.DeletePost(tt.postID)
+			err := storage.DeletePost(ctx, tt.postID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DeletePost() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
 			if !tt.wantErr {
-				_, err := storage.GetPost(tt.postID)
+				_, err := storage.GetPost(ctx, tt.postID)
 				if err == nil {
 					t.Error("DeletePost() should remove post from storage")
 				}
@@ -207,6 +216,7 @@ func TestMemoryStorage_DeletePost(t *testing.T) {
 
 func TestMemoryStorage_ConcurrentAccess(t *testing.T) {
 	storage := NewMemoryStorage()
+	ctx := context.Background()
 
 	done := make(chan bool, 10)
 
@@ -214,23 +224,23 @@ func TestMemoryStorage_ConcurrentAccess(t *testing.T) {
 		go func(id int) {
 			defer func() { done <- true }()
 
-			post, err := storage.CreatePost("Title", "Content", "Author", timestamppb.New(time.Now()), []string{"tag"})
+			post, err := storage.CreatePost(ctx, "Title", "Content", "Author", timestamppb.New(time.Now()), []string{"tag"})
 			if err != nil {
 				t.Errorf("Concurrent CreatePost() failed: %v", err)
 				return
 			}
 
-			_, err = storage.GetPost(post.PostId)
+			_, err = storage.GetPost(ctx, post.PostId)
 			if err != nil {
 				t.Errorf("Concurrent GetPost() failed: %v", err)
 			}
 
-			_, err = storage.UpdatePost(post.PostId, "New Title", "New Content", "New Author", []string{"new"})
+			_, err = storage.UpdatePost(ctx, post.PostId, "New Title", "New Content", "New Author", []string{"new"})
 			if err != nil {
 				t.Errorf("Concurrent UpdatePost() failed: %v", err)
 			}
 
-			err = storage.DeletePost(post.PostId)
+			err = storage.DeletePost(ctx, post.PostId)
 			if err != nil {
 				t.Errorf("Concurrent DeletePost() failed: %v", err)
 			}
@@ -244,4 +254,74 @@ func TestMemoryStorage_ConcurrentAccess(t *testing.T) {
 			t.Fatal("Test timed out - possible deadlock")
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestMemoryStorage_Stats(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+
+	if stats := storage.Stats(ctx); stats.Posts != 0 || stats.Tags != 0 {
+		t.Errorf("Stats() on empty storage = %+v, want zero", stats)
+	}
+
+	pubDate := timestamppb.New(time.Now())
+	if _, err := storage.CreatePost(ctx, "One", "Content", "Author", pubDate, []string{"go", "grpc"}); err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+	if _, err := storage.CreatePost(ctx, "Two", "Content", "Author", pubDate, []string{"go"}); err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+
+	stats := storage.Stats(ctx)
+	if stats.Posts != 2 {
+		t.Errorf("Stats() posts = %v, want 2", stats.Posts)
+	}
+	if stats.Tags != 2 {
+		t.Errorf("Stats() tags = %v, want 2", stats.Tags)
+	}
+}
+
+func TestMemoryStorage_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	storage := NewMemoryStorage()
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+
+	post, err := storage.CreatePost(ctx, "Title", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+	if _, err := storage.GetPost(ctx, post.PostId); err != nil {
+		t.Fatalf("GetPost() error = %v", err)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	names := make(map[string]sdktrace.ReadOnlySpan)
+	for _, s := range spans {
+		names[s.Name()] = s
+	}
+
+	for _, name := range []string{"MemoryStorage.CreatePost", "MemoryStorage.GetPost"} {
+		span, ok := names[name]
+		if !ok {
+			t.Errorf("missing span %s", name)
+			continue
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("span %s should be a child of the caller's span", name)
+		}
+		var hasLockWait bool
+		for _, attr := range span.Attributes() {
+			if attr.Key == "storage.lock_wait_us" {
+				hasLockWait = true
+			}
+		}
+		if !hasLockWait {
+			t.Errorf("span %s should record lock wait time", name)
+		}
+	}
+}
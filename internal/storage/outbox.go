@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/outbox"
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// publishInterval is how often RunPublisher checks for scheduled posts
+// that are due.
+const publishInterval = time.Second
+
+// recordEvent adds an event about post to the outbox. Every change to a
+// post records its event under the same write lock as the change itself,
+// so the event exists exactly when the change does. The caller must hold
+// the write lock.
+func (s *MemoryStorage) recordEvent(eventType string, post *proto.BlogPost) {
+	s.appendEvent(outbox.NewEvent(eventType, s.withReactions(post), time.Now()))
+}
+
+// recordPublication adds the event for the publication of post, which
+// happens at its publication date. Posts scheduled for later are recorded
+// by PublishDue once their date is reached, so that no sink hears of them
+// before they are published. The caller must hold the write lock.
+func (s *MemoryStorage) recordPublication(post *proto.BlogPost, now time.Time) {
+	if at := post.PublicationDate.AsTime(); at.After(now) {
+		s.scheduled[post.PostId] = at
+		return
+	}
+	s.appendEvent(outbox.NewEvent(outbox.EventPostPublished, s.withReactions(post), post.PublicationDate.AsTime()))
+}
+
+// PublishDue records the publication of every scheduled post whose
+// publication date is not after now, oldest first, and returns how many
+// it recorded.
+func (s *MemoryStorage) PublishDue(ctx context.Context, now time.Time) int {
+	span, done := s.begin(ctx, "PublishDue", true)
+	defer done()
+
+	var due []*proto.BlogPost
+	for id, at := range s.scheduled {
+		if !at.After(now) {
+			due = append(due, s.posts[id])
+			delete(s.scheduled, id)
+		}
+	}
+	slices.SortFunc(due, func(a, b *proto.BlogPost) int {
+		if c := a.PublicationDate.AsTime().Compare(b.PublicationDate.AsTime()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PostId, b.PostId)
+	})
+	for _, post := range due {
+		s.recordPublication(post, now)
+	}
+	span.SetAttributes(attribute.Int("outbox.published", len(due)))
+	return len(due)
+}
+
+// RunPublisher publishes scheduled posts as they become due until ctx is
+// cancelled.
+func (s *MemoryStorage) RunPublisher(ctx context.Context) {
+	ticker := time.NewTicker(publishInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.PublishDue(ctx, now)
+		}
+	}
+}
+
+// appendEvent assigns e the next sequence number and wakes the relay. The
+// caller must hold the write lock.
+func (s *MemoryStorage) appendEvent(e outbox.Event) {
+	s.outboxSequence++
+	e.Sequence = s.outboxSequence
+	s.outbox = append(s.outbox, e)
+	select {
+	case s.outboxReady <- struct{}{}:
+	default:
+	}
+}
+
+// RegisterOutboxConsumer starts keeping events for consumer from the
+// oldest event still in the outbox. Consumers must be registered before
+// any of them acknowledges events, or a consumer registered later misses
+// the events trimmed before it. Registering a consumer again has no effect.
+func (s *MemoryStorage) RegisterOutboxConsumer(ctx context.Context, consumer string) {
+	span, done := s.begin(ctx, "RegisterOutboxConsumer", true)
+	defer done()
+	span.SetAttributes(attribute.String("outbox.consumer", consumer))
+
+	if _, exists := s.outboxCursors[consumer]; !exists {
+		s.outboxCursors[consumer] = s.outboxTrimmed
+	}
+}
+
+// OutboxEvents returns up to limit events that consumer has not
+// acknowledged, oldest first. It returns nothing for consumers that are
+// not registered.
+func (s *MemoryStorage) OutboxEvents(ctx context.Context, consumer string, limit int) []outbox.Event {
+	span, done := s.begin(ctx, "OutboxEvents", false)
+	defer done()
+	span.SetAttributes(attribute.String("outbox.consumer", consumer))
+
+	acked, exists := s.outboxCursors[consumer]
+	if !exists {
+		return nil
+	}
+	start := int(acked - s.outboxTrimmed)
+	end := min(start+limit, len(s.outbox))
+	return append([]outbox.Event(nil), s.outbox[start:end]...)
+}
+
+// AckOutbox records that consumer has handled every event up to and
+// including sequence, and drops the events every consumer has handled.
+func (s *MemoryStorage) AckOutbox(ctx context.Context, consumer string, sequence uint64) {
+	span, done := s.begin(ctx, "AckOutbox", true)
+	defer done()
+	span.SetAttributes(attribute.String("outbox.consumer", consumer), attribute.Int64("outbox.sequence", int64(sequence)))
+
+	acked, exists := s.outboxCursors[consumer]
+	if !exists || sequence <= acked || sequence > s.outboxSequence {
+		return
+	}
+	s.outboxCursors[consumer] = sequence
+
+	lowest := sequence
+	for _, acked := range s.outboxCursors {
+		lowest = min(lowest, acked)
+	}
+	if lowest > s.outboxTrimmed {
+		s.outbox = append([]outbox.Event(nil), s.outbox[lowest-s.outboxTrimmed:]...)
+		s.outboxTrimmed = lowest
+	}
+}
+
+// OutboxReady receives a value after new events are recorded.
+func (s *MemoryStorage) OutboxReady() <-chan struct{} {
+	return s.outboxReady
+}
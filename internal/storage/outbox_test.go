@@ -0,0 +1,298 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/broker"
+	"github.com/kpauljoseph/test/internal/outbox"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_OutboxRecordsPostChanges(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	category, _ := storage.CreateCategory(ctx, "Tech", "")
+	storage.CreateAuthor(ctx, &proto.Author{AuthorId: "bob", DisplayName: "Bob"})
+	publishAt := time.Now().Add(time.Hour)
+	storage.RegisterOutboxConsumer(ctx, "test")
+
+	if _, err := storage.CreatePost(ctx, "Title", "Content", "alice", timestamppb.Now(), nil, WithCategory("missing")); err == nil {
+		t.Fatal("CreatePost() with missing category expected error")
+	}
+	if events := storage.OutboxEvents(ctx, "test", 10); len(events) != 0 || storage.Stats(ctx).Posts != 0 {
+		t.Fatalf("failed CreatePost() left %d events and %d posts, want none", len(events), storage.Stats(ctx).Posts)
+	}
+
+	post, err := storage.CreatePost(ctx, "Title", "Content", "alice", timestamppb.New(publishAt), []string{"go"},
+		WithCategory(category.CategoryId),
+		WithCoAuthors([]string{"bob", "alice"}),
+	)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+	if post.CategoryId != category.CategoryId || !reflect.DeepEqual(post.CoAuthorIds, []string{"bob"}) {
+		t.Errorf("CreatePost() = %v, want category and co-author bob", post)
+	}
+	if n := storage.PublishDue(ctx, time.Now()); n != 0 {
+		t.Errorf("PublishDue() before the publication date = %d, want 0", n)
+	}
+	if n := storage.PublishDue(ctx, publishAt); n != 1 {
+		t.Errorf("PublishDue() at the publication date = %d, want 1", n)
+	}
+	storage.UpdatePost(ctx, post.PostId, "New title", "Content", "alice", []string{"go"})
+	storage.UpdatePost(ctx, post.PostId, "New title", "Content", "alice", []string{"go"}, WithCoAuthors(nil))
+	storage.RenameTag(ctx, "go", "golang")
+	storage.UpdateAuthor(ctx, &proto.Author{AuthorId: "alice", DisplayName: "Alice"})
+	storage.UpdateAuthor(ctx, &proto.Author{AuthorId: "alice", DisplayName: "Alice"})
+	storage.DeletePost(ctx, post.PostId)
+
+	events := storage.OutboxEvents(ctx, "test", 100)
+	var types []string
+	for i, e := range events {
+		types = append(types, e.Type)
+		if e.Sequence != uint64(i+1) || e.PostID != post.PostId || e.ID == "" {
+			t.Errorf("event %d = %+v", i, e)
+		}
+	}
+	want := []string{
+		outbox.EventPostCreated,
+		outbox.EventPostPublished,
+		outbox.EventPostUpdated,
+		outbox.EventPostUpdated,
+		outbox.EventPostUpdated,
+		outbox.EventPostUpdated,
+		outbox.EventPostDeleted,
+	}
+	if !reflect.DeepEqual(types, want) {
+		t.Fatalf("event types = %v, want %v", types, want)
+	}
+
+	// Every event carries the post as it was after its change.
+	if created := events[0].Post; created.CategoryId != category.CategoryId || len(created.CoAuthorIds) != 1 || created.Title != "Title" {
+		t.Errorf("created event post = %v", created)
+	}
+	if !events[1].OccurredAt.Equal(publishAt) {
+		t.Errorf("published event occurred at %v, want %v", events[1].OccurredAt, publishAt)
+	}
+	if got := events[4].Post.Tags; !reflect.DeepEqual(got, []string{"golang"}) {
+		t.Errorf("renamed tag event tags = %v, want [golang]", got)
+	}
+	if got := events[5].Post.Author; got != "Alice" {
+		t.Errorf("author update event author = %q, want Alice", got)
+	}
+	if events[6].Post != nil {
+		t.Errorf("deleted event post = %v, want nil", events[6].Post)
+	}
+
+	select {
+	case <-storage.OutboxReady():
+	default:
+		t.Error("OutboxReady() not signalled after writes")
+	}
+}
+
+func TestMemoryStorage_OutboxConsumers(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	sequences := func(events []outbox.Event) []uint64 {
+		var result []uint64
+		for _, e := range events {
+			result = append(result, e.Sequence)
+		}
+		return result
+	}
+
+	// Register both consumers before anything is recorded.
+	storage.RegisterOutboxConsumer(ctx, "fast")
+	storage.RegisterOutboxConsumer(ctx, "slow")
+	for i := 0; i < 2; i++ {
+		storage.CreatePost(ctx, "Title", "Content", "alice", timestamppb.Now(), nil)
+	}
+
+	if got := sequences(storage.OutboxEvents(ctx, "fast", 3)); !reflect.DeepEqual(got, []uint64{1, 2, 3}) {
+		t.Fatalf("OutboxEvents(fast) = %v, want [1 2 3]", got)
+	}
+	storage.AckOutbox(ctx, "fast", 3)
+	if got := sequences(storage.OutboxEvents(ctx, "fast", 10)); !reflect.DeepEqual(got, []uint64{4}) {
+		t.Errorf("OutboxEvents(fast) after ack = %v, want [4]", got)
+	}
+	storage.AckOutbox(ctx, "fast", 2)
+	if got := sequences(storage.OutboxEvents(ctx, "fast", 10)); !reflect.DeepEqual(got, []uint64{4}) {
+		t.Errorf("OutboxEvents(fast) after stale ack = %v, want [4]", got)
+	}
+
+	// Events stay until the slow consumer has them too.
+	if got := sequences(storage.OutboxEvents(ctx, "slow", 10)); !reflect.DeepEqual(got, []uint64{1, 2, 3, 4}) {
+		t.Errorf("OutboxEvents(slow) = %v, want [1 2 3 4]", got)
+	}
+	storage.AckOutbox(ctx, "slow", 4)
+	storage.AckOutbox(ctx, "fast", 4)
+	if len(storage.outbox) != 0 {
+		t.Errorf("outbox holds %d events after every consumer acknowledged them", len(storage.outbox))
+	}
+
+	storage.CreatePost(ctx, "Title", "Content", "alice", timestamppb.Now(), nil)
+	storage.RegisterOutboxConsumer(ctx, "late")
+	for _, consumer := range []string{"fast", "slow", "late"} {
+		if got := sequences(storage.OutboxEvents(ctx, consumer, 10)); !reflect.DeepEqual(got, []uint64{5, 6}) {
+			t.Errorf("OutboxEvents(%s) = %v, want [5 6]", consumer, got)
+		}
+	}
+	if got := storage.OutboxEvents(ctx, "unregistered", 10); len(got) != 0 {
+		t.Errorf("OutboxEvents(unregistered) = %v, want none", sequences(got))
+	}
+}
+
+func TestMemoryStorage_OutboxSinkPollingLate(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	fast := &collectingSink{}
+	late := &collectingSink{}
+
+	relay := outbox.NewRelay(storage)
+	relay.AddSink("fast", fast)
+	relay.AddSink("late", late)
+
+	storage.CreatePost(ctx, "Title", "Content", "alice", timestamppb.Now(), nil)
+
+	// The fast sink handles every event before the late one first polls.
+	for _, e := range storage.OutboxEvents(ctx, "fast", 10) {
+		fast.Deliver(ctx, e)
+		storage.AckOutbox(ctx, "fast", e.Sequence)
+	}
+	if got := len(fast.events); got != 2 {
+		t.Fatalf("fast sink got %d events, want 2", got)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		relay.Run(runCtx)
+		close(done)
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for late.count() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if got := late.count(); got != 2 {
+		t.Errorf("late sink got %d events, want 2", got)
+	}
+}
+
+// collectingSink records the events delivered to it.
+type collectingSink struct {
+	mu     sync.Mutex
+	events []outbox.Event
+}
+
+func (s *collectingSink) Deliver(ctx context.Context, e outbox.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *collectingSink) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+func TestMemoryStorage_ScheduledPostsPublishOnTheirDate(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	b := broker.New()
+	var mu sync.Mutex
+	var brokerEvents []string
+	b.Subscribe("blog.>", "", func(m broker.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+		brokerEvents = append(brokerEvents, m.Subject)
+	})
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	fileSink, err := outbox.NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	defer fileSink.Close()
+
+	relay := outbox.NewRelay(storage)
+	relay.AddSink("broker", outbox.NewBrokerSink(b, "blog"))
+	relay.AddSink("file", fileSink)
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		relay.Run(runCtx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// received waits until both sinks have n events and returns the
+	// subjects and file event types.
+	received := func(n int) (subjects, fileEvents []string) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			fileEvents = nil
+			data, _ := os.ReadFile(path)
+			for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+				var p struct {
+					Type string `json:"type"`
+				}
+				if json.Unmarshal([]byte(line), &p) == nil {
+					fileEvents = append(fileEvents, p.Type)
+				}
+			}
+			mu.Lock()
+			subjects = slices.Clone(brokerEvents)
+			mu.Unlock()
+			if len(subjects) >= n && len(fileEvents) >= n {
+				return subjects, fileEvents
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("sinks received %v and %v, want %d events each", subjects, fileEvents, n)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	publishAt := time.Now().Add(time.Hour)
+	storage.CreatePost(ctx, "Scheduled", "Content", "alice", timestamppb.New(publishAt), nil)
+	storage.PublishDue(ctx, time.Now())
+	// The events of a post published right away follow the scheduled
+	// post's creation, so once they arrive nothing else is in flight.
+	storage.CreatePost(ctx, "Marker", "Content", "alice", timestamppb.Now(), nil)
+
+	subjects, fileEvents := received(3)
+	if want := []string{"blog.post.created", "blog.post.created", "blog.post.published"}; !reflect.DeepEqual(subjects, want) {
+		t.Errorf("broker subjects before the publication date = %v, want %v", subjects, want)
+	}
+	if want := []string{outbox.EventPostCreated, outbox.EventPostCreated, outbox.EventPostPublished}; !reflect.DeepEqual(fileEvents, want) {
+		t.Errorf("file events before the publication date = %v, want %v", fileEvents, want)
+	}
+
+	storage.PublishDue(ctx, publishAt)
+	subjects, fileEvents = received(4)
+	if len(subjects) != 4 || subjects[3] != "blog.post.published" {
+		t.Errorf("broker subjects after the publication date = %v, want a final blog.post.published", subjects)
+	}
+	if len(fileEvents) != 4 || fileEvents[3] != outbox.EventPostPublished {
+		t.Errorf("file events after the publication date = %v, want a final post.published", fileEvents)
+	}
+}
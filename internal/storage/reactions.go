@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/trending"
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// reactionSet records which users left each reaction type on a post, and
+// when, so that removing a reaction takes it back from the hour it counted
+// towards.
+type reactionSet map[string]map[string]time.Time
+
+// AddReaction records user's reaction on a post and returns the post's
+// updated counts. Each user counts at most once per reaction type, so
+// repeating a reaction has no effect.
+func (s *MemoryStorage) AddReaction(ctx context.Context, postID, reaction, user string) (map[string]int64, error) {
+	span, done := s.begin(ctx, "AddReaction", true)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID), attribute.String("reaction", reaction))
+
+	if _, exists := s.posts[postID]; !exists {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+
+	set, ok := s.reactions[postID]
+	if !ok {
+		set = make(reactionSet)
+		s.reactions[postID] = set
+	}
+	users, ok := set[reaction]
+	if !ok {
+		users = make(map[string]time.Time)
+		set[reaction] = users
+	}
+	if _, ok := users[user]; !ok {
+		now := time.Now()
+		users[user] = now
+		s.trending.Record(postID, trending.Reaction, 1, now)
+	}
+
+	return s.reactionCounts(postID), nil
+}
+
+// RemoveReaction removes user's reaction from a post and returns the post's
+// updated counts. Removing a reaction that was never left is not an error.
+func (s *MemoryStorage) RemoveReaction(ctx context.Context, postID, reaction, user string) (map[string]int64, error) {
+	span, done := s.begin(ctx, "RemoveReaction", true)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID), attribute.String("reaction", reaction))
+
+	if _, exists := s.posts[postID]; !exists {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+
+	if users, ok := s.reactions[postID][reaction]; ok {
+		if added, ok := users[user]; ok {
+			delete(users, user)
+			s.trending.Record(postID, trending.Reaction, -1, added)
+		}
+		if len(users) == 0 {
+			delete(s.reactions[postID], reaction)
+		}
+	}
+
+	return s.reactionCounts(postID), nil
+}
+
+// reactionCounts returns the number of users per reaction type on a post.
+// The caller must hold the lock.
+func (s *MemoryStorage) reactionCounts(postID string) map[string]int64 {
+	set := s.reactions[postID]
+	if len(set) == 0 {
+		return nil
+	}
+	counts := make(map[string]int64, len(set))
+	for reaction, users := range set {
+		counts[reaction] = int64(len(users))
+	}
+	return counts
+}
+
+// withReactions returns a copy of post carrying its reaction counts. The
+// caller must hold the lock.
+func (s *MemoryStorage) withReactions(post *proto.BlogPost) *proto.BlogPost {
+	clone := protobuf.Clone(post).(*proto.BlogPost)
+	clone.ReactionCounts = s.reactionCounts(post.PostId)
+	return clone
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_Reactions(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+
+	post, err := storage.CreatePost(ctx, "Post", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	if _, err := storage.AddReaction(ctx, post.PostId, "like", "alice"); err != nil {
+		t.Fatalf("AddReaction() error = %v", err)
+	}
+	if _, err := storage.AddReaction(ctx, post.PostId, "like", "alice"); err != nil {
+		t.Fatalf("AddReaction() repeat error = %v", err)
+	}
+	counts, err := storage.AddReaction(ctx, post.PostId, "clap", "alice")
+	if err != nil {
+		t.Fatalf("AddReaction() error = %v", err)
+	}
+	if counts["like"] != 1 || counts["clap"] != 1 {
+		t.Errorf("AddReaction() counts = %v, want like=1 clap=1", counts)
+	}
+
+	got, err := storage.GetPost(ctx, post.PostId)
+	if err != nil {
+		t.Fatalf("GetPost() error = %v", err)
+	}
+	if got.ReactionCounts["like"] != 1 {
+		t.Errorf("GetPost() reaction counts = %v, want like=1", got.ReactionCounts)
+	}
+
+	counts, err = storage.RemoveReaction(ctx, post.PostId, "like", "alice")
+	if err != nil {
+		t.Fatalf("RemoveReaction() error = %v", err)
+	}
+	if _, ok := counts["like"]; ok {
+		t.Errorf("RemoveReaction() counts = %v, want no likes", counts)
+	}
+	if _, err := storage.RemoveReaction(ctx, post.PostId, "like", "alice"); err != nil {
+		t.Errorf("RemoveReaction() repeat error = %v", err)
+	}
+
+	if _, err := storage.AddReaction(ctx, "missing", "like", "alice"); err == nil {
+		t.Error("AddReaction() on missing post expected error")
+	}
+}
+
+func TestMemoryStorage_ConcurrentReactions(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+
+	post, err := storage.CreatePost(ctx, "Post", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	const users = 50
+	var wg sync.WaitGroup
+	for i := 0; i < users; i++ {
+		wg.Add(1)
+		go func(user string) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				storage.AddReaction(ctx, post.PostId, "like", user)
+				storage.GetPost(ctx, post.PostId)
+			}
+		}(fmt.Sprintf("user-%d", i))
+	}
+	wg.Wait()
+
+	got, err := storage.GetPost(ctx, post.PostId)
+	if err != nil {
+		t.Fatalf("GetPost() error = %v", err)
+	}
+	if got.ReactionCounts["like"] != users {
+		t.Errorf("like count = %d, want %d", got.ReactionCounts["like"], users)
+	}
+}
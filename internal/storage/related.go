@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// RelatedPost is a post with its similarity score to another post.
+type RelatedPost struct {
+	Post  *proto.BlogPost
+	Score float64
+}
+
+// RelatedPosts returns up to limit posts most similar to postID, highest
+// score first.
+func (s *MemoryStorage) RelatedPosts(ctx context.Context, postID string, limit int) ([]RelatedPost, error) {
+	span, done := s.begin(ctx, "RelatedPosts", false)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
+
+	if _, exists := s.posts[postID]; !exists {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+
+	matches := s.related.Related(postID, limit)
+	result := make([]RelatedPost, 0, len(matches))
+	for _, m := range matches {
+		result = append(result, RelatedPost{
+			Post:  s.withReactions(s.posts[m.PostID]),
+			Score: m.Score,
+		})
+	}
+	return result, nil
+}
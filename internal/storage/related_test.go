@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_RelatedPosts(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	base, _ := storage.CreatePost(ctx, "Channels", "Go channels and goroutines", "alice", date, []string{"go"})
+	similar, _ := storage.CreatePost(ctx, "Select", "Using select with Go channels", "bob", date, []string{"go"})
+	other, _ := storage.CreatePost(ctx, "Bread", "Baking sourdough bread", "carol", date, []string{"baking"})
+
+	got, err := storage.RelatedPosts(ctx, base.PostId, 10)
+	if err != nil {
+		t.Fatalf("RelatedPosts() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Post.PostId != similar.PostId {
+		t.Fatalf("RelatedPosts() = %v, want only %s", got, similar.PostId)
+	}
+
+	// Updating a post re-indexes it.
+	if _, err := storage.UpdatePost(ctx, other.PostId, "Bread", "Go channels for bakers", "carol", []string{"go"}); err != nil {
+		t.Fatalf("UpdatePost() error = %v", err)
+	}
+	got, err = storage.RelatedPosts(ctx, base.PostId, 10)
+	if err != nil {
+		t.Fatalf("RelatedPosts() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("RelatedPosts() after update = %v, want 2 posts", got)
+	}
+
+	if err := storage.DeletePost(ctx, similar.PostId); err != nil {
+		t.Fatalf("DeletePost() error = %v", err)
+	}
+	got, err = storage.RelatedPosts(ctx, base.PostId, 10)
+	if err != nil {
+		t.Fatalf("RelatedPosts() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Post.PostId != other.PostId {
+		t.Errorf("RelatedPosts() after delete = %v, want only %s", got, other.PostId)
+	}
+
+	if _, err := storage.RelatedPosts(ctx, "missing", 10); err == nil {
+		t.Error("RelatedPosts() on missing post expected error")
+	}
+}
@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func (s *MemoryStorage) CreateSeries(ctx context.Context, title, description, author string) (*proto.Series, error) {
+	span, done := s.begin(ctx, "CreateSeries", true)
+	defer done()
+
+	series := &proto.Series{
+		SeriesId:    uuid.New().String(),
+		Title:       title,
+		Description: description,
+		Author:      author,
+	}
+	s.series[series.SeriesId] = series
+	span.SetAttributes(attribute.String("series.id", series.SeriesId))
+	return protobuf.Clone(series).(*proto.Series), nil
+}
+
+func (s *MemoryStorage) GetSeries(ctx context.Context, seriesID string) (*proto.Series, error) {
+	span, done := s.begin(ctx, "GetSeries", false)
+	defer done()
+	span.SetAttributes(attribute.String("series.id", seriesID))
+
+	series, exists := s.series[seriesID]
+	if !exists {
+		return nil, fmt.Errorf("series with ID %s not found", seriesID)
+	}
+	return protobuf.Clone(series).(*proto.Series), nil
+}
+
+// AddPostToSeries appends postID to the series. A post can belong to only
+// one series at a time.
+func (s *MemoryStorage) AddPostToSeries(ctx context.Context, seriesID, postID string) (*proto.Series, error) {
+	span, done := s.begin(ctx, "AddPostToSeries", true)
+	defer done()
+	span.SetAttributes(attribute.String("series.id", seriesID), attribute.String("post.id", postID))
+
+	series, exists := s.series[seriesID]
+	if !exists {
+		return nil, fmt.Errorf("series with ID %s not found", seriesID)
+	}
+	if _, exists := s.posts[postID]; !exists {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+	if current, ok := s.seriesOfPost[postID]; ok {
+		return nil, fmt.Errorf("post %s is already part of series %s", postID, current)
+	}
+
+	series.PostIds = append(series.PostIds, postID)
+	s.seriesOfPost[postID] = seriesID
+	return protobuf.Clone(series).(*proto.Series), nil
+}
+
+// ReorderSeries sets the reading order of the series. postIDs must list
+// every member post exactly once.
+func (s *MemoryStorage) ReorderSeries(ctx context.Context, seriesID string, postIDs []string) (*proto.Series, error) {
+	span, done := s.begin(ctx, "ReorderSeries", true)
+	defer done()
+	span.SetAttributes(attribute.String("series.id", seriesID))
+
+	series, exists := s.series[seriesID]
+	if !exists {
+		return nil, fmt.Errorf("series with ID %s not found", seriesID)
+	}
+	sorted, members := slices.Clone(postIDs), slices.Clone(series.PostIds)
+	slices.Sort(sorted)
+	slices.Sort(members)
+	if !slices.Equal(sorted, members) {
+		return nil, fmt.Errorf("post_ids must list every post of series %s exactly once", seriesID)
+	}
+
+	series.PostIds = slices.Clone(postIDs)
+	return protobuf.Clone(series).(*proto.Series), nil
+}
+
+// SeriesNavigation returns where postID sits in its series, or nil when it
+// is not part of one.
+func (s *MemoryStorage) SeriesNavigation(ctx context.Context, postID string) *proto.SeriesNavigation {
+	span, done := s.begin(ctx, "SeriesNavigation", false)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
+
+	seriesID, ok := s.seriesOfPost[postID]
+	if !ok {
+		return nil
+	}
+	series := s.series[seriesID]
+	i := slices.Index(series.PostIds, postID)
+
+	nav := &proto.SeriesNavigation{
+		SeriesId: seriesID,
+		Title:    series.Title,
+		Position: int32(i + 1),
+		Total:    int32(len(series.PostIds)),
+	}
+	if i > 0 {
+		nav.PreviousPostId = series.PostIds[i-1]
+	}
+	if i < len(series.PostIds)-1 {
+		nav.NextPostId = series.PostIds[i+1]
+	}
+	return nav
+}
+
+// removeFromSeries drops a deleted post from its series. The caller must
+// hold the write lock.
+func (s *MemoryStorage) removeFromSeries(postID string) {
+	seriesID, ok := s.seriesOfPost[postID]
+	if !ok {
+		return
+	}
+	series := s.series[seriesID]
+	series.PostIds = slices.DeleteFunc(series.PostIds, func(id string) bool { return id == postID })
+	delete(s.seriesOfPost, postID)
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	proto "github.com/kpauljoseph/test/proto"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_Series(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	series, err := storage.CreateSeries(ctx, "Tutorial", "Learn Go", "Author")
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	var ids []string
+	for _, title := range []string{"Part 1", "Part 2", "Part 3"} {
+		post, _ := storage.CreatePost(ctx, title, "Content", "Author", date, nil)
+		if _, err := storage.AddPostToSeries(ctx, series.SeriesId, post.PostId); err != nil {
+			t.Fatalf("AddPostToSeries() error = %v", err)
+		}
+		ids = append(ids, post.PostId)
+	}
+
+	other, _ := storage.CreateSeries(ctx, "Other", "", "Author")
+	if _, err := storage.AddPostToSeries(ctx, other.SeriesId, ids[0]); err == nil {
+		t.Error("AddPostToSeries() of post in another series expected error")
+	}
+	if _, err := storage.AddPostToSeries(ctx, series.SeriesId, "missing"); err == nil {
+		t.Error("AddPostToSeries() of missing post expected error")
+	}
+
+	if _, err := storage.ReorderSeries(ctx, series.SeriesId, []string{ids[0], ids[1]}); err == nil {
+		t.Error("ReorderSeries() missing a member expected error")
+	}
+	reordered, err := storage.ReorderSeries(ctx, series.SeriesId, []string{ids[2], ids[0], ids[1]})
+	if err != nil {
+		t.Fatalf("ReorderSeries() error = %v", err)
+	}
+	if want := []string{ids[2], ids[0], ids[1]}; !reflect.DeepEqual(reordered.PostIds, want) {
+		t.Errorf("ReorderSeries() = %v, want %v", reordered.PostIds, want)
+	}
+
+	tests := []struct {
+		name string
+		post string
+		want *proto.SeriesNavigation
+	}{
+		{
+			name: "first",
+			post: ids[2],
+			want: &proto.SeriesNavigation{SeriesId: series.SeriesId, Title: "Tutorial", Position: 1, Total: 3, NextPostId: ids[0]},
+		},
+		{
+			name: "middle",
+			post: ids[0],
+			want: &proto.SeriesNavigation{SeriesId: series.SeriesId, Title: "Tutorial", Position: 2, Total: 3, PreviousPostId: ids[2], NextPostId: ids[1]},
+		},
+		{
+			name: "last",
+			post: ids[1],
+			want: &proto.SeriesNavigation{SeriesId: series.SeriesId, Title: "Tutorial", Position: 3, Total: 3, PreviousPostId: ids[0]},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := storage.SeriesNavigation(ctx, tt.post)
+			if !protobuf.Equal(got, tt.want) {
+				t.Errorf("SeriesNavigation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	storage.DeletePost(ctx, ids[0])
+	got, _ := storage.GetSeries(ctx, series.SeriesId)
+	if want := []string{ids[2], ids[1]}; !reflect.DeepEqual(got.PostIds, want) {
+		t.Errorf("series after DeletePost() = %v, want %v", got.PostIds, want)
+	}
+	if nav := storage.SeriesNavigation(ctx, ids[0]); nav != nil {
+		t.Errorf("SeriesNavigation() of deleted post = %v, want nil", nav)
+	}
+}
@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/kpauljoseph/test/internal/outbox"
+	"github.com/kpauljoseph/test/internal/tagging"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// TagCount is a tag and the number of posts carrying it.
+type TagCount struct {
+	Tag   string
+	Posts int
+}
+
+// TagCounts returns every tag in use, most used first.
+func (s *MemoryStorage) TagCounts(ctx context.Context) []TagCount {
+	_, done := s.begin(ctx, "TagCounts", false)
+	defer done()
+
+	counts := make(map[string]int)
+	for _, post := range s.posts {
+		for _, tag := range post.Tags {
+			counts[tag]++
+		}
+	}
+
+	result := make([]TagCount, 0, len(counts))
+	for tag, n := range counts {
+		result = append(result, TagCount{Tag: tag, Posts: n})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Posts == result[j].Posts {
+			return result[i].Tag < result[j].Tag
+		}
+		return result[i].Posts > result[j].Posts
+	})
+	return result
+}
+
+// TagChange is the outcome of renaming or merging tags.
+type TagChange struct {
+	// Posts is the number of posts whose tags changed.
+	Posts int
+	// Followers are the users who follow one of the tags involved, sorted.
+	// Their home feeds change along with the posts.
+	Followers []string
+}
+
+// RenameTag replaces tag from with to on every post and for every user
+// following it. It fails if no post has from or if to is already in use,
+// in which case MergeTags should be used instead.
+func (s *MemoryStorage) RenameTag(ctx context.Context, from, to string) (TagChange, error) {
+	span, done := s.begin(ctx, "RenameTag", true)
+	defer done()
+	span.SetAttributes(attribute.String("tag.from", from), attribute.String("tag.to", to))
+
+	found := false
+	for _, post := range s.posts {
+		if slices.Contains(post.Tags, to) {
+			return TagChange{}, fmt.Errorf("tag %s already exists", to)
+		}
+		found = found || slices.Contains(post.Tags, from)
+	}
+	if !found {
+		return TagChange{}, fmt.Errorf("tag %s not found", from)
+	}
+
+	return TagChange{
+		Posts:     s.replaceTags([]string{from}, to),
+		Followers: s.moveFollowedTags([]string{from}, to),
+	}, nil
+}
+
+// MergeTags replaces every tag in sources with target on all posts and for
+// every user following them. Posts end up with target once, at the
+// position of the first source tag they had.
+func (s *MemoryStorage) MergeTags(ctx context.Context, sources []string, target string) (TagChange, error) {
+	span, done := s.begin(ctx, "MergeTags", true)
+	defer done()
+	span.SetAttributes(attribute.StringSlice("tag.sources", sources), attribute.String("tag.target", target))
+
+	changed := s.replaceTags(sources, target)
+	if changed == 0 {
+		return TagChange{}, fmt.Errorf("none of the tags %v are in use", sources)
+	}
+	return TagChange{
+		Posts:     changed,
+		Followers: s.moveFollowedTags(sources, target),
+	}, nil
+}
+
+// replaceTags rewrites the tags of every post carrying one of sources. The
+// caller must hold the write lock.
+func (s *MemoryStorage) replaceTags(sources []string, target string) int {
+	changed := 0
+	for id, post := range s.posts {
+		if !slices.ContainsFunc(post.Tags, func(tag string) bool { return slices.Contains(sources, tag) }) {
+			continue
+		}
+
+		tags := make([]string, 0, len(post.Tags))
+		for _, tag := range post.Tags {
+			if slices.Contains(sources, tag) {
+				tag = target
+			}
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		s.unindexPost(post)
+		s.retag(post.Tags, tags)
+		post.Tags = tags
+		s.indexPost(post)
+		s.related.Put(id, post.AuthorId, post.Content, post.Tags)
+		s.recordEvent(outbox.EventPostUpdated, post)
+		changed++
+	}
+	return changed
+}
+
+// SuggestTags returns up to limit tags in use that start with query or
+// with a string within maxDistance edits of it.
+func (s *MemoryStorage) SuggestTags(ctx context.Context, query string, maxDistance, limit int) []tagging.Suggestion {
+	_, done := s.begin(ctx, "SuggestTags", false)
+	defer done()
+
+	return s.tagTrie.Suggest(query, maxDistance, limit)
+}
+
+// retag moves a post's tag usage in the suggestion trie from old to new.
+// The caller must hold the write lock.
+func (s *MemoryStorage) retag(old, new []string) {
+	for _, tag := range old {
+		s.tagTrie.Remove(tag)
+	}
+	for _, tag := range new {
+		s.tagTrie.Add(tag)
+	}
+}
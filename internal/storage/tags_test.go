@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_TagCounts(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	storage.CreatePost(ctx, "One", "Content", "Author", date, []string{"go", "grpc"})
+	storage.CreatePost(ctx, "Two", "Content", "Author", date, []string{"go"})
+
+	want := []TagCount{{Tag: "go", Posts: 2}, {Tag: "grpc", Posts: 1}}
+	if got := storage.TagCounts(ctx); !reflect.DeepEqual(got, want) {
+		t.Errorf("TagCounts() = %v, want %v", got, want)
+	}
+}
+
+func TestMemoryStorage_RenameAndMergeTags(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	a, _ := storage.CreatePost(ctx, "A", "Content", "Author", date, []string{"golang", "web"})
+	b, _ := storage.CreatePost(ctx, "B", "Content", "Author", date, []string{"Go", "golang"})
+	c, _ := storage.CreatePost(ctx, "C", "Content", "Author", date, []string{"go-lang"})
+
+	if _, err := storage.RenameTag(ctx, "missing", "x"); err == nil {
+		t.Error("RenameTag() of unused tag expected error")
+	}
+	if _, err := storage.RenameTag(ctx, "golang", "web"); err == nil {
+		t.Error("RenameTag() onto existing tag expected error")
+	}
+
+	change, err := storage.RenameTag(ctx, "web", "http")
+	if err != nil || change.Posts != 1 {
+		t.Fatalf("RenameTag() = %+v, %v, want 1 post", change, err)
+	}
+
+	change, err = storage.MergeTags(ctx, []string{"golang", "Go", "go-lang"}, "go")
+	if err != nil || change.Posts != 3 {
+		t.Fatalf("MergeTags() = %+v, %v, want 3 posts", change, err)
+	}
+
+	tests := []struct {
+		id   string
+		want []string
+	}{
+		{id: a.PostId, want: []string{"go", "http"}},
+		{id: b.PostId, want: []string{"go"}},
+		{id: c.PostId, want: []string{"go"}},
+	}
+	for _, tt := range tests {
+		post, err := storage.GetPost(ctx, tt.id)
+		if err != nil {
+			t.Fatalf("GetPost() error = %v", err)
+		}
+		if !reflect.DeepEqual(post.Tags, tt.want) {
+			t.Errorf("post %s tags = %v, want %v", tt.id, post.Tags, tt.want)
+		}
+	}
+
+	if _, err := storage.MergeTags(ctx, []string{"golang"}, "go"); err == nil {
+		t.Error("MergeTags() of unused tags expected error")
+	}
+}
+
+func TestMemoryStorage_SuggestTagsFollowsWrites(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	suggest := func(query string) []string {
+		var tags []string
+		for _, s := range storage.SuggestTags(ctx, query, 0, 10) {
+			tags = append(tags, s.Tag)
+		}
+		return tags
+	}
+
+	a, _ := storage.CreatePost(ctx, "A", "Content", "Author", date, []string{"kubernetes", "kafka"})
+	storage.CreatePost(ctx, "B", "Content", "Author", date, []string{"kafka"})
+
+	if got, want := suggest("k"), []string{"kafka", "kubernetes"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SuggestTags() = %v, want %v", got, want)
+	}
+
+	if _, err := storage.UpdatePost(ctx, a.PostId, "A", "Content", "Author", []string{"kotlin"}); err != nil {
+		t.Fatalf("UpdatePost() error = %v", err)
+	}
+	if got, want := suggest("k"), []string{"kafka", "kotlin"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SuggestTags() after update = %v, want %v", got, want)
+	}
+
+	if _, err := storage.RenameTag(ctx, "kotlin", "kt"); err != nil {
+		t.Fatalf("RenameTag() error = %v", err)
+	}
+	if got, want := suggest("k"), []string{"kafka", "kt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SuggestTags() after rename = %v, want %v", got, want)
+	}
+
+	if err := storage.DeletePost(ctx, a.PostId); err != nil {
+		t.Fatalf("DeletePost() error = %v", err)
+	}
+	if got, want := suggest("k"), []string{"kafka"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SuggestTags() after delete = %v, want %v", got, want)
+	}
+}
+
+func TestMemoryStorage_RenameAndMergeTagsMoveFollows(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	date := timestamppb.New(time.Now())
+
+	post, _ := storage.CreatePost(ctx, "A", "Content", "Author", date, []string{"golang", "web"})
+	storage.Follow(ctx, "web-reader", "", "web")
+	storage.Follow(ctx, "go-reader", "", "golang")
+	storage.Follow(ctx, "go-reader", "", "go")
+	storage.Follow(ctx, "bystander", "", "rust")
+
+	change, err := storage.RenameTag(ctx, "web", "http")
+	if err != nil {
+		t.Fatalf("RenameTag() error = %v", err)
+	}
+	if want := []string{"web-reader"}; !reflect.DeepEqual(change.Followers, want) {
+		t.Errorf("RenameTag() followers = %v, want %v", change.Followers, want)
+	}
+	change, err = storage.MergeTags(ctx, []string{"golang"}, "go")
+	if err != nil {
+		t.Fatalf("MergeTags() error = %v", err)
+	}
+	if want := []string{"go-reader"}; !reflect.DeepEqual(change.Followers, want) {
+		t.Errorf("MergeTags() followers = %v, want %v", change.Followers, want)
+	}
+
+	tests := []struct {
+		user string
+		want []string
+	}{
+		{user: "web-reader", want: []string{"http"}},
+		{user: "go-reader", want: []string{"go"}},
+		{user: "bystander", want: []string{"rust"}},
+	}
+	for _, tt := range tests {
+		if _, tags := storage.Following(ctx, tt.user); !reflect.DeepEqual(tags, tt.want) {
+			t.Errorf("Following(%s) tags = %v, want %v", tt.user, tags, tt.want)
+		}
+	}
+	for _, user := range []string{"web-reader", "go-reader"} {
+		sources := storage.FeedSources(ctx, user, 10, time.Now())
+		if len(sources) != 1 || sources[0][0].PostID != post.PostId {
+			t.Errorf("FeedSources(%s) = %v, want the renamed post", user, sources)
+		}
+	}
+}
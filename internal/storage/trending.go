@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/trending"
+	proto "github.com/kpauljoseph/test/proto"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// TrendingPost is a post with its trending score.
+type TrendingPost struct {
+	Post  *proto.BlogPost
+	Score float64
+}
+
+// TrendingPosts returns up to limit posts with engagement, highest score
+// first. A non-empty tag restricts the result to posts with that tag and
+// applies the tag's trending window.
+func (s *MemoryStorage) TrendingPosts(ctx context.Context, tag string, limit int, now time.Time) ([]TrendingPost, error) {
+	span, done := s.begin(ctx, "TrendingPosts", false)
+	defer done()
+	span.SetAttributes(attribute.String("tag", tag))
+
+	var result []TrendingPost
+	for _, id := range s.trending.Active(now) {
+		post, exists := s.posts[id]
+		if !exists || (tag != "" && !slices.Contains(post.Tags, tag)) {
+			continue
+		}
+		score := s.trending.Score(id, tag, post.PublicationDate.AsTime(), now)
+		if score <= 0 {
+			continue
+		}
+		result = append(result, TrendingPost{Post: post, Score: score})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score == result[j].Score {
+			return result[i].Post.PostId < result[j].Post.PostId
+		}
+		return result[i].Score > result[j].Score
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	for i := range result {
+		result[i].Post = s.withReactions(result[i].Post)
+	}
+	return result, nil
+}
+
+// recordCommentEngagement updates the trending engagement of a comment's
+// post when the comment becomes visible or stops being visible. The caller
+// must hold the write lock.
+func (s *MemoryStorage) recordCommentEngagement(comment *proto.Comment, wasVisible bool) {
+	visible := commentVisible(comment)
+	switch {
+	case visible && !wasVisible:
+		now := time.Now()
+		s.commentedAt[comment.CommentId] = now
+		s.trending.Record(comment.PostId, trending.Comment, 1, now)
+	case !visible && wasVisible:
+		// Take the comment back from the hour it was counted in.
+		counted, ok := s.commentedAt[comment.CommentId]
+		if !ok {
+			return
+		}
+		delete(s.commentedAt, comment.CommentId)
+		s.trending.Record(comment.PostId, trending.Comment, -1, counted)
+	}
+}
+
+func commentVisible(comment *proto.Comment) bool {
+	return !comment.Deleted && comment.ModerationState == proto.ModerationState_MODERATION_STATE_APPROVED
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/trending"
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_TrendingPosts(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+	now := time.Now()
+
+	quiet, _ := storage.CreatePost(ctx, "Quiet", "Content", "Author", timestamppb.New(now), []string{"go"})
+	viewed, _ := storage.CreatePost(ctx, "Viewed", "Content", "Author", timestamppb.New(now), []string{"go"})
+	discussed, _ := storage.CreatePost(ctx, "Discussed", "Content", "Author", timestamppb.New(now), []string{"news"})
+
+	if _, err := storage.RecordView(ctx, viewed.PostId, "alice", now, time.Minute); err != nil {
+		t.Fatalf("RecordView() error = %v", err)
+	}
+	if _, err := storage.AddComment(ctx, discussed.PostId, "", "alice", "Hi", proto.ModerationState_MODERATION_STATE_APPROVED, ""); err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+	if _, err := storage.AddComment(ctx, quiet.PostId, "", "spammer", "Buy", proto.ModerationState_MODERATION_STATE_SPAM, ""); err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+
+	got, err := storage.TrendingPosts(ctx, "", 10, now)
+	if err != nil {
+		t.Fatalf("TrendingPosts() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Post.PostId != discussed.PostId || got[1].Post.PostId != viewed.PostId {
+		t.Fatalf("TrendingPosts() = %v, want discussed then viewed", got)
+	}
+
+	got, err = storage.TrendingPosts(ctx, "go", 10, now)
+	if err != nil {
+		t.Fatalf("TrendingPosts() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Post.PostId != viewed.PostId {
+		t.Errorf("TrendingPosts() for go = %v, want only viewed", got)
+	}
+
+	if err := storage.DeletePost(ctx, discussed.PostId); err != nil {
+		t.Fatalf("DeletePost() error = %v", err)
+	}
+	got, err = storage.TrendingPosts(ctx, "", 1, now)
+	if err != nil {
+		t.Fatalf("TrendingPosts() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Post.PostId != viewed.PostId {
+		t.Errorf("TrendingPosts() after delete = %v, want only viewed", got)
+	}
+}
+
+func TestMemoryStorage_RemovingOldReactionKeepsRecentScore(t *testing.T) {
+	storage := NewMemoryStorage(WithTrending(&trending.Config{Gravity: 1, WindowHours: 24, TagWindowHours: map[string]int{"news": 2}, ReactionWeight: 1}))
+	ctx := context.Background()
+	now := time.Now()
+	post, _ := storage.CreatePost(ctx, "News", "Content", "Author", timestamppb.New(now), []string{"news"})
+
+	// A reaction left five hours ago, before the news window.
+	reacted := now.Add(-5 * time.Hour)
+	storage.reactions[post.PostId] = reactionSet{"like": {"early": reacted}}
+	storage.trending.Record(post.PostId, trending.Reaction, 1, reacted)
+	storage.AddReaction(ctx, post.PostId, "like", "recent")
+
+	before, _ := storage.TrendingPosts(ctx, "news", 10, now)
+	storage.RemoveReaction(ctx, post.PostId, "like", "early")
+	after, _ := storage.TrendingPosts(ctx, "news", 10, now)
+	if len(before) != 1 || len(after) != 1 || after[0].Score != before[0].Score {
+		t.Errorf("news scores before and after removing an old reaction = %v, %v, want the same", before, after)
+	}
+}
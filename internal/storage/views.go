@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/trending"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ViewBucket is the number of views of a post in the hour or day starting
+// at Start.
+type ViewBucket struct {
+	Start time.Time
+	Views int64
+}
+
+// RecordView counts a view of a post by viewer at the given time, unless
+// the same viewer was already counted less than window ago. It reports
+// whether the view was counted.
+func (s *MemoryStorage) RecordView(ctx context.Context, postID, viewer string, at time.Time, window time.Duration) (bool, error) {
+	span, done := s.begin(ctx, "RecordView", true)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
+
+	if _, exists := s.posts[postID]; !exists {
+		return false, fmt.Errorf("post with ID %s not found", postID)
+	}
+
+	s.sweepViewers(at, window)
+	key := postID + "\x00" + viewer
+	if last, ok := s.lastViews[key]; ok && at.Sub(last) < window {
+		return false, nil
+	}
+	s.lastViews[key] = at
+
+	hours, ok := s.views[postID]
+	if !ok {
+		hours = make(map[int64]int64)
+		s.views[postID] = hours
+	}
+	hours[at.Truncate(time.Hour).Unix()]++
+	s.trending.Record(postID, trending.View, 1, at)
+	return true, nil
+}
+
+// ViewCounts returns the views of a post in consecutive buckets of size
+// (an hour or a day) covering [start, end). Daily buckets start at UTC
+// midnight.
+func (s *MemoryStorage) ViewCounts(ctx context.Context, postID string, start, end time.Time, size time.Duration) ([]ViewBucket, error) {
+	span, done := s.begin(ctx, "ViewCounts", false)
+	defer done()
+	span.SetAttributes(attribute.String("post.id", postID))
+
+	if _, exists := s.posts[postID]; !exists {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+
+	hours := s.views[postID]
+	var buckets []ViewBucket
+	for t := start.UTC().Truncate(size); t.Before(end); t = t.Add(size) {
+		bucket := ViewBucket{Start: t}
+		for h := t; h.Before(t.Add(size)); h = h.Add(time.Hour) {
+			bucket.Views += hours[h.Unix()]
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets, nil
+}
+
+// sweepViewers forgets viewers whose de-duplication window has passed. It
+// runs at most once per window. The caller must hold the write lock.
+func (s *MemoryStorage) sweepViewers(now time.Time, window time.Duration) {
+	if now.Sub(s.lastViewSweep) < window {
+		return
+	}
+	s.lastViewSweep = now
+	for key, last := range s.lastViews {
+		if now.Sub(last) >= window {
+			delete(s.lastViews, key)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStorage_RecordView(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := context.Background()
+
+	post, err := storage.CreatePost(ctx, "Post", "Content", "Author", timestamppb.New(time.Now()), nil)
+	if err != nil {
+		t.Fatalf("CreatePost() error = %v", err)
+	}
+
+	base := time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC)
+	window := 30 * time.Minute
+
+	tests := []struct {
+		name   string
+		viewer string
+		at     time.Time
+		want   bool
+	}{
+		{name: "first view", viewer: "alice", at: base, want: true},
+		{name: "repeat within window", viewer: "alice", at: base.Add(10 * time.Minute), want: false},
+		{name: "other viewer", viewer: "bob", at: base.Add(10 * time.Minute), want: true},
+		{name: "repeat after window", viewer: "alice", at: base.Add(50 * time.Minute), want: true},
+		{name: "next day", viewer: "alice", at: base.Add(24 * time.Hour), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := storage.RecordView(ctx, post.PostId, tt.viewer, tt.at, window)
+			if err != nil {
+				t.Fatalf("RecordView() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RecordView() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := storage.RecordView(ctx, "missing", "alice", base, window); err == nil {
+		t.Error("RecordView() on missing post expected error")
+	}
+
+	hourly, err := storage.ViewCounts(ctx, post.PostId, base.Truncate(time.Hour), base.Add(2*time.Hour), time.Hour)
+	if err != nil {
+		t.Fatalf("ViewCounts() error = %v", err)
+	}
+	if len(hourly) != 3 || hourly[0].Views != 2 || hourly[1].Views != 1 || hourly[2].Views != 0 {
+		t.Errorf("ViewCounts() hourly = %+v, want [2 1 0]", hourly)
+	}
+
+	daily, err := storage.ViewCounts(ctx, post.PostId, base, base.Add(48*time.Hour), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("ViewCounts() error = %v", err)
+	}
+	if len(daily) != 3 || daily[0].Views != 3 || daily[1].Views != 1 {
+		t.Errorf("ViewCounts() daily = %+v, want [3 1 0]", daily)
+	}
+	if !daily[0].Start.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ViewCounts() first day starts at %v, want UTC midnight", daily[0].Start)
+	}
+}
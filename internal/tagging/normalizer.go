@@ -0,0 +1,100 @@
+package tagging
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config controls how tags are normalized before they are stored.
+// Synonyms map alternative spellings to a canonical tag; keys are matched
+// after trimming and case folding.
+type Config struct {
+	CaseFold bool              `json:"case_fold"`
+	Trim     bool              `json:"trim"`
+	Synonyms map[string]string `json:"synonyms"`
+}
+
+// DefaultConfig trims and lower-cases tags without any synonyms.
+func DefaultConfig() *Config {
+	return &Config{
+		CaseFold: true,
+		Trim:     true,
+	}
+}
+
+// LoadConfig reads a JSON tag normalization config file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read tag config: %w", err)
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse tag config: %w", err)
+	}
+	for from, to := range c.Synonyms {
+		if strings.TrimSpace(from) == "" || strings.TrimSpace(to) == "" {
+			return nil, fmt.Errorf("synonym %q -> %q must not be empty", from, to)
+		}
+	}
+	return &c, nil
+}
+
+// Normalizer applies a Config to tags.
+type Normalizer struct {
+	config   Config
+	synonyms map[string]string
+}
+
+// NewNormalizer creates a Normalizer for config.
+func NewNormalizer(config *Config) *Normalizer {
+	n := &Normalizer{config: *config, synonyms: make(map[string]string, len(config.Synonyms))}
+	for from, to := range config.Synonyms {
+		n.synonyms[n.clean(from)] = n.clean(to)
+	}
+	return n
+}
+
+// Tag returns the normalized form of tag.
+func (n *Normalizer) Tag(tag string) string {
+	tag = n.clean(tag)
+	if canonical, ok := n.synonyms[tag]; ok {
+		return canonical
+	}
+	return tag
+}
+
+// Tags normalizes tags, dropping empty and repeated ones while keeping the
+// original order.
+func (n *Normalizer) Tags(tags []string) []string {
+	if len(tags) == 0 {
+		return tags
+	}
+	seen := make(map[string]struct{}, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = n.Tag(tag)
+		if tag == "" {
+			continue
+		}
+		if _, dup := seen[tag]; dup {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+	return out
+}
+
+func (n *Normalizer) clean(tag string) string {
+	if n.config.Trim {
+		tag = strings.TrimSpace(tag)
+	}
+	if n.config.CaseFold {
+		tag = strings.ToLower(tag)
+	}
+	return tag
+}
@@ -0,0 +1,82 @@
+package tagging
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizer_Tags(t *testing.T) {
+	n := NewNormalizer(&Config{
+		CaseFold: true,
+		Trim:     true,
+		Synonyms: map[string]string{"golang": "go", "Go-Lang": "go"},
+	})
+
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{name: "case folding", tags: []string{"Go", "gRPC"}, want: []string{"go", "grpc"}},
+		{name: "trimming", tags: []string{" go ", "testing\t"}, want: []string{"go", "testing"}},
+		{name: "synonyms", tags: []string{"golang", "GO-LANG", "rust"}, want: []string{"go", "rust"}},
+		{name: "duplicates after normalization", tags: []string{"Go", "go", "golang"}, want: []string{"go"}},
+		{name: "empty tags dropped", tags: []string{" ", "go"}, want: []string{"go"}},
+		{name: "no tags", tags: nil, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := n.Tags(tt.tags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizer_Disabled(t *testing.T) {
+	n := NewNormalizer(&Config{})
+	if got := n.Tag(" Go "); got != " Go " {
+		t.Errorf("Tag() = %q, want input unchanged", got)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name:    "valid config",
+			data:    `{"case_fold": true, "trim": true, "synonyms": {"golang": "go"}}`,
+			wantErr: false,
+		},
+		{
+			name:    "empty synonym target",
+			data:    `{"synonyms": {"golang": ""}}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			data:    `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "tags.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatalf("Failed to write config: %v", err)
+			}
+
+			_, err := LoadConfig(path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package tagging
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Suggestion is a tag matching a query.
+type Suggestion struct {
+	Tag   string
+	Posts int
+	// Distance is the edit distance between the query and the closest
+	// prefix of the tag; zero for exact prefix matches.
+	Distance int
+}
+
+// Trie indexes tags with their usage counts for suggestions. It is not
+// safe for concurrent use; callers provide their own locking.
+type Trie struct {
+	root *trieNode
+}
+
+type trieNode struct {
+	children map[rune]*trieNode
+	tag      string
+	posts    int
+}
+
+// NewTrie creates an empty Trie.
+func NewTrie() *Trie {
+	return &Trie{root: &trieNode{}}
+}
+
+// Add counts one more post using tag.
+func (t *Trie) Add(tag string) {
+	node := t.root
+	for _, r := range tag {
+		child, ok := node.children[r]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[rune]*trieNode)
+			}
+			child = &trieNode{}
+			node.children[r] = child
+		}
+		node = child
+	}
+	node.tag = tag
+	node.posts++
+}
+
+// Remove counts one post fewer using tag, pruning nodes that no longer
+// lead to a tag.
+func (t *Trie) Remove(tag string) {
+	path := []*trieNode{t.root}
+	node := t.root
+	for _, r := range tag {
+		child, ok := node.children[r]
+		if !ok {
+			return
+		}
+		node = child
+		path = append(path, node)
+	}
+	if node.posts == 0 {
+		return
+	}
+	node.posts--
+
+	runes := []rune(tag)
+	for i := len(path) - 1; i > 0; i-- {
+		n := path[i]
+		if n.posts > 0 || len(n.children) > 0 {
+			break
+		}
+		delete(path[i-1].children, runes[i-1])
+	}
+}
+
+// Suggest returns up to limit tags that start with query or with a string
+// within maxDistance edits of it. Exact prefix matches come first, then
+// closer matches; ties are broken by usage.
+func (t *Trie) Suggest(query string, maxDistance, limit int) []Suggestion {
+	var out []Suggestion
+	row := make([]int, utf8.RuneCountInString(query)+1)
+	for i := range row {
+		row[i] = i
+	}
+	t.search(t.root, []rune(query), row, maxDistance+1, maxDistance, &out)
+
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+		if a.Posts != b.Posts {
+			return a.Posts > b.Posts
+		}
+		return a.Tag < b.Tag
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// search walks the trie computing one row of the Levenshtein table per
+// node. best is the smallest distance between the query and any prefix of
+// the path so far; every tag below a node whose best is within maxDistance
+// matches.
+func (t *Trie) search(node *trieNode, query []rune, row []int, best, maxDistance int, out *[]Suggestion) {
+	best = min(best, row[len(query)])
+	if node.posts > 0 && best <= maxDistance {
+		*out = append(*out, Suggestion{Tag: node.tag, Posts: node.posts, Distance: best})
+	}
+
+	lowest := minInt(row)
+	if lowest > maxDistance && best > maxDistance {
+		return
+	}
+	if lowest >= best {
+		// Longer paths cannot get closer to the query.
+		for _, child := range node.children {
+			collect(child, best, out)
+		}
+		return
+	}
+
+	for r, child := range node.children {
+		next := make([]int, len(row))
+		next[0] = row[0] + 1
+		for i := 1; i < len(row); i++ {
+			cost := 1
+			if query[i-1] == r {
+				cost = 0
+			}
+			next[i] = min(next[i-1]+1, row[i]+1, row[i-1]+cost)
+		}
+		t.search(child, query, next, best, maxDistance, out)
+	}
+}
+
+func collect(node *trieNode, distance int, out *[]Suggestion) {
+	if node.posts > 0 {
+		*out = append(*out, Suggestion{Tag: node.tag, Posts: node.posts, Distance: distance})
+	}
+	for _, child := range node.children {
+		collect(child, distance, out)
+	}
+}
+
+func minInt(values []int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		m = min(m, v)
+	}
+	return m
+}
@@ -0,0 +1,79 @@
+package tagging
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestTrie() *Trie {
+	t := NewTrie()
+	for tag, posts := range map[string]int{"go": 5, "golang": 2, "gopher": 1, "grpc": 3, "graphql": 1, "rust": 4} {
+		for i := 0; i < posts; i++ {
+			t.Add(tag)
+		}
+	}
+	return t
+}
+
+func suggestionTags(s []Suggestion) []string {
+	var tags []string
+	for _, x := range s {
+		tags = append(tags, x.Tag)
+	}
+	return tags
+}
+
+func TestTrie_Suggest(t *testing.T) {
+	trie := newTestTrie()
+
+	tests := []struct {
+		name        string
+		query       string
+		maxDistance int
+		limit       int
+		want        []string
+	}{
+		{name: "prefix ranked by usage", query: "go", maxDistance: 0, limit: 10, want: []string{"go", "golang", "gopher"}},
+		{name: "limit", query: "g", maxDistance: 0, limit: 2, want: []string{"go", "grpc"}},
+		{name: "typo in prefix", query: "gol", maxDistance: 1, limit: 10, want: []string{"golang", "go", "gopher"}},
+		{name: "transposition", query: "rsut", maxDistance: 2, limit: 10, want: []string{"rust"}},
+		{name: "no match", query: "python", maxDistance: 1, limit: 10, want: nil},
+		{name: "empty query lists everything", query: "", maxDistance: 0, limit: 3, want: []string{"go", "rust", "grpc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := suggestionTags(trie.Suggest(tt.query, tt.maxDistance, tt.limit))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Suggest(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrie_SuggestDistance(t *testing.T) {
+	trie := newTestTrie()
+
+	got := trie.Suggest("golnag", 2, 1)
+	if len(got) != 1 || got[0].Tag != "golang" || got[0].Distance != 2 || got[0].Posts != 2 {
+		t.Errorf("Suggest(golnag) = %+v, want golang at distance 2 with 2 posts", got)
+	}
+}
+
+func TestTrie_Remove(t *testing.T) {
+	trie := newTestTrie()
+
+	trie.Remove("gopher")
+	trie.Remove("missing")
+	trie.Remove("go")
+	if got := suggestionTags(trie.Suggest("gop", 0, 10)); got != nil {
+		t.Errorf("Suggest(gop) after Remove = %v, want none", got)
+	}
+	got := trie.Suggest("go", 0, 1)
+	if len(got) != 1 || got[0].Posts != 4 {
+		t.Errorf("Suggest(go) after Remove = %+v, want go with 4 posts", got)
+	}
+	if _, ok := trie.root.children['g'].children['o'].children['p']; ok {
+		t.Error("Remove() should prune nodes without tags")
+	}
+}
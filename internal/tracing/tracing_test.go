@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestSetup_FileOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+
+	shutdown, err := Setup(Config{ServiceName: "blog-test", Output: path})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "test-span")
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if !strings.HasPrefix(carrier.Get("traceparent"), "00-"+span.SpanContext().TraceID().String()) {
+		t.Errorf("traceparent = %q, want W3C trace context for the span", carrier.Get("traceparent"))
+	}
+	span.End()
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read trace output: %v", err)
+	}
+	for _, want := range []string{`"Name":"test-span"`, `"blog-test"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("trace output missing %s:\n%s", want, data)
+		}
+	}
+}
+
+func TestSetup_Disabled(t *testing.T) {
+	shutdown, err := Setup(Config{ServiceName: "blog-test"})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+}
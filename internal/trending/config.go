@@ -0,0 +1,79 @@
+package trending
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Config controls how engagement is weighted and for how long it counts.
+//
+// Engagement older than WindowHours is ignored; TagWindowHours overrides the
+// window when listing the trending posts of a tag, so that fast-moving tags
+// can use a shorter window than evergreen ones. The score of a post is its
+// weighted engagement divided by (age in hours + 2) ^ Gravity.
+type Config struct {
+	Gravity        float64        `json:"gravity"`
+	WindowHours    int            `json:"window_hours"`
+	TagWindowHours map[string]int `json:"tag_window_hours"`
+	ViewWeight     float64        `json:"view_weight"`
+	ReactionWeight float64        `json:"reaction_weight"`
+	CommentWeight  float64        `json:"comment_weight"`
+}
+
+// DefaultConfig returns Hacker News style gravity over two days of
+// engagement, with comments counting more than reactions and reactions
+// more than views.
+func DefaultConfig() *Config {
+	return &Config{
+		Gravity:        1.8,
+		WindowHours:    48,
+		ViewWeight:     1,
+		ReactionWeight: 3,
+		CommentWeight:  5,
+	}
+}
+
+// LoadConfig reads a JSON trending configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read trending config: %w", err)
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse trending config: %w", err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (c *Config) validate() error {
+	if c.Gravity <= 0 {
+		return fmt.Errorf("gravity must be positive")
+	}
+	if c.WindowHours < 1 {
+		return fmt.Errorf("window_hours must be at least 1")
+	}
+	for tag, hours := range c.TagWindowHours {
+		if hours < 1 {
+			return fmt.Errorf("window for tag %q must be at least 1 hour", tag)
+		}
+	}
+	if c.ViewWeight < 0 || c.ReactionWeight < 0 || c.CommentWeight < 0 {
+		return fmt.Errorf("weights must not be negative")
+	}
+	return nil
+}
+
+// windowFor returns the window in hours used when listing tag, or the
+// default window when tag is empty or has no override.
+func (c *Config) windowFor(tag string) int {
+	if hours, ok := c.TagWindowHours[tag]; ok {
+		return hours
+	}
+	return c.WindowHours
+}
@@ -0,0 +1,55 @@
+package trending
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name:    "valid config",
+			data:    `{"gravity": 1.5, "window_hours": 24, "tag_window_hours": {"news": 6}, "view_weight": 1, "reaction_weight": 2, "comment_weight": 4}`,
+			wantErr: false,
+		},
+		{
+			name:    "missing gravity",
+			data:    `{"window_hours": 24}`,
+			wantErr: true,
+		},
+		{
+			name:    "zero tag window",
+			data:    `{"gravity": 1.8, "window_hours": 24, "tag_window_hours": {"news": 0}}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			data:    `{"gravity": 1.8, "window_hours": 24, "view_weight": -1}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			data:    `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "trending.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatalf("Failed to write config: %v", err)
+			}
+
+			_, err := LoadConfig(path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package trending
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// Kind is a type of engagement.
+type Kind int
+
+const (
+	View Kind = iota
+	Reaction
+	Comment
+)
+
+// Tracker keeps each post's engagement in hourly buckets together with a
+// running total per configured window, so that recording engagement and
+// scoring a post do not need to revisit its history. Posts are forgotten
+// once their engagement has left every window. It is not safe for
+// concurrent use; callers provide their own locking.
+type Tracker struct {
+	config  Config
+	windows []int
+	posts   map[string]*counter
+	// swept is the hour of the last sweep over every post.
+	swept int64
+}
+
+// counter holds the engagement of one post.
+type counter struct {
+	buckets map[int64]float64 // hour number -> points
+	sums    []windowSum       // parallel to Tracker.windows
+	latest  int64             // hour number of the newest engagement
+}
+
+// windowSum is the total of the buckets from hour first onwards.
+type windowSum struct {
+	first int64
+	total float64
+}
+
+// NewTracker creates a Tracker for config.
+func NewTracker(config *Config) *Tracker {
+	windows := []int{config.WindowHours}
+	for _, hours := range config.TagWindowHours {
+		if !slices.Contains(windows, hours) {
+			windows = append(windows, hours)
+		}
+	}
+	return &Tracker{
+		config:  *config,
+		windows: windows,
+		posts:   make(map[string]*counter),
+	}
+}
+
+// Record adds n engagements of kind to a post at time at. A negative n
+// takes back engagement recorded at at, e.g. a removed reaction; taking
+// back engagement that has already left every window has no effect.
+func (t *Tracker) Record(postID string, kind Kind, n int, at time.Time) {
+	points := float64(n) * t.weight(kind)
+	if points == 0 {
+		return
+	}
+
+	hour := hourOf(at)
+	c, ok := t.posts[postID]
+	if points < 0 {
+		if !ok {
+			return
+		}
+		if _, recorded := c.buckets[hour]; !recorded {
+			return
+		}
+	}
+	if !ok {
+		c = &counter{buckets: make(map[int64]float64), sums: make([]windowSum, len(t.windows))}
+		for i, hours := range t.windows {
+			c.sums[i].first = hour - int64(hours) + 1
+		}
+		t.posts[postID] = c
+	}
+
+	c.buckets[hour] += points
+	c.latest = max(c.latest, hour)
+	for i, hours := range t.windows {
+		s := &c.sums[i]
+		t.advance(c, s, hour-int64(hours)+1)
+		if hour >= s.first {
+			s.total += points
+		}
+	}
+	t.prune(c, hour)
+	t.sweep(hour)
+}
+
+// Remove forgets a post's engagement.
+func (t *Tracker) Remove(postID string) {
+	delete(t.posts, postID)
+}
+
+// Active returns the IDs of posts with engagement within the longest
+// window at now.
+func (t *Tracker) Active(now time.Time) []string {
+	oldest := t.oldest(hourOf(now))
+	ids := make([]string, 0, len(t.posts))
+	for id, c := range t.posts {
+		if c.latest >= oldest {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Score returns the trending score at now of a post published at
+// published, counting engagement within the window configured for tag.
+func (t *Tracker) Score(postID, tag string, published, now time.Time) float64 {
+	c, ok := t.posts[postID]
+	if !ok {
+		return 0
+	}
+
+	hours := t.config.windowFor(tag)
+	i := slices.Index(t.windows, hours)
+	points := t.sumSince(c, c.sums[i], hourOf(now)-int64(hours)+1)
+	if points <= 0 {
+		return 0
+	}
+
+	age := now.Sub(published).Hours()
+	if age < 0 {
+		age = 0
+	}
+	return points / math.Pow(age+2, t.config.Gravity)
+}
+
+func (t *Tracker) weight(kind Kind) float64 {
+	switch kind {
+	case View:
+		return t.config.ViewWeight
+	case Reaction:
+		return t.config.ReactionWeight
+	case Comment:
+		return t.config.CommentWeight
+	}
+	return 0
+}
+
+// advance moves the start of a window sum forward to first, subtracting the
+// buckets that fell out of it.
+func (t *Tracker) advance(c *counter, s *windowSum, first int64) {
+	s.total = t.sumSince(c, *s, first)
+	if first > s.first {
+		s.first = first
+	}
+}
+
+// sumSince returns what the total of s would be if its window started at
+// first, without modifying s.
+func (t *Tracker) sumSince(c *counter, s windowSum, first int64) float64 {
+	if first <= s.first {
+		return s.total
+	}
+	total := s.total
+	if first-s.first > int64(len(c.buckets)) {
+		// Fewer buckets exist than hours to skip, so walk the buckets.
+		for hour, points := range c.buckets {
+			if hour >= s.first && hour < first {
+				total -= points
+			}
+		}
+		return total
+	}
+	for hour := s.first; hour < first; hour++ {
+		total -= c.buckets[hour]
+	}
+	return total
+}
+
+// prune drops buckets older than the longest window.
+func (t *Tracker) prune(c *counter, now int64) {
+	oldest := t.oldest(now)
+	for hour := range c.buckets {
+		if hour < oldest {
+			delete(c.buckets, hour)
+		}
+	}
+}
+
+// sweep forgets the posts whose engagement has left every window, and
+// prunes the buckets of the others. It runs at most once an hour.
+func (t *Tracker) sweep(now int64) {
+	if now <= t.swept {
+		return
+	}
+	t.swept = now
+	oldest := t.oldest(now)
+	for id, c := range t.posts {
+		if c.latest < oldest {
+			delete(t.posts, id)
+			continue
+		}
+		t.prune(c, now)
+	}
+}
+
+// oldest returns the first hour of the longest window ending at now.
+func (t *Tracker) oldest(now int64) int64 {
+	return now - int64(slices.Max(t.windows)) + 1
+}
+
+func hourOf(at time.Time) int64 {
+	return at.Unix() / 3600
+}
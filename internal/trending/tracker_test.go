@@ -0,0 +1,145 @@
+package trending
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTracker_Score(t *testing.T) {
+	tracker := NewTracker(&Config{
+		Gravity:        1.8,
+		WindowHours:    24,
+		TagWindowHours: map[string]int{"news": 2},
+		ViewWeight:     1,
+		ReactionWeight: 3,
+		CommentWeight:  5,
+	})
+
+	published := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := published.Add(10 * time.Hour)
+
+	tracker.Record("a", View, 4, now.Add(-5*time.Hour))
+	tracker.Record("a", Reaction, 1, now.Add(-time.Hour))
+	tracker.Record("a", Comment, 1, now)
+
+	want := 12 / math.Pow(12, 1.8)
+	if got := tracker.Score("a", "", published, now); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score() = %v, want %v", got, want)
+	}
+
+	// The news window only covers the last two hours.
+	want = 8 / math.Pow(12, 1.8)
+	if got := tracker.Score("a", "news", published, now); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score() for news = %v, want %v", got, want)
+	}
+
+	// Later, engagement leaves the window without further records.
+	later := now.Add(20 * time.Hour)
+	want = 8 / math.Pow(32, 1.8)
+	if got := tracker.Score("a", "", published, later); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score() later = %v, want %v", got, want)
+	}
+	if got := tracker.Score("a", "", published, now.Add(48*time.Hour)); got != 0 {
+		t.Errorf("Score() after window = %v, want 0", got)
+	}
+
+	tracker.Record("a", Reaction, -1, now)
+	want = 9 / math.Pow(12, 1.8)
+	if got := tracker.Score("a", "", published, now); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score() after removal = %v, want %v", got, want)
+	}
+
+	tracker.Remove("a")
+	if got := tracker.Score("a", "", published, now); got != 0 {
+		t.Errorf("Score() after Remove = %v, want 0", got)
+	}
+}
+
+func TestTracker_GravityFavoursNewPosts(t *testing.T) {
+	tracker := NewTracker(DefaultConfig())
+	now := time.Now()
+
+	tracker.Record("old", View, 10, now)
+	tracker.Record("new", View, 10, now)
+
+	old := tracker.Score("old", "", now.Add(-24*time.Hour), now)
+	fresh := tracker.Score("new", "", now.Add(-time.Hour), now)
+	if fresh <= old {
+		t.Errorf("new post score %v should exceed old post score %v", fresh, old)
+	}
+}
+
+func TestTracker_WindowAdvancesAcrossRecords(t *testing.T) {
+	tracker := NewTracker(&Config{Gravity: 1, WindowHours: 3, ViewWeight: 1})
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for h := 0; h < 10; h++ {
+		tracker.Record("a", View, 1, start.Add(time.Duration(h)*time.Hour))
+	}
+
+	now := start.Add(9 * time.Hour)
+	want := 3 / math.Pow(2, 1)
+	if got := tracker.Score("a", "", now, now); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score() = %v, want %v", got, want)
+	}
+}
+
+func TestTracker_RemovalTakesBackFromRecordedHour(t *testing.T) {
+	tracker := NewTracker(&Config{Gravity: 1, WindowHours: 24, TagWindowHours: map[string]int{"news": 2}, ReactionWeight: 1})
+	published := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := published.Add(30 * time.Hour)
+	reacted := now.Add(-5 * time.Hour)
+
+	tracker.Record("a", Reaction, 1, reacted)
+	tracker.Record("a", Reaction, 1, now)
+
+	// Removing the reaction from five hours ago lowers the day's total but
+	// not the last two hours, which never counted it.
+	tracker.Record("a", Reaction, -1, reacted)
+	if got, want := tracker.Score("a", "", published, now), 1/float64(32); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score() after removal = %v, want %v", got, want)
+	}
+	if got, want := tracker.Score("a", "news", published, now), 1/float64(32); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score() for news after removal = %v, want %v", got, want)
+	}
+
+	// Engagement that has left every window cannot be taken back again.
+	tracker.Record("a", Reaction, -1, now.Add(-48*time.Hour))
+	tracker.Record("b", Reaction, -1, now)
+	if got, want := tracker.Score("a", "", published, now), 1/float64(32); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score() after removing expired engagement = %v, want %v", got, want)
+	}
+	if got := tracker.Score("b", "", published, now); got != 0 {
+		t.Errorf("Score() of post without engagement after removal = %v, want 0", got)
+	}
+}
+
+func TestTracker_ForgetsExpiredPosts(t *testing.T) {
+	tracker := NewTracker(&Config{Gravity: 1, WindowHours: 3, TagWindowHours: map[string]int{"slow": 6}, ViewWeight: 1})
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tracker.Record("old", View, 1, start)
+	tracker.Record("new", View, 1, start.Add(4*time.Hour))
+	if got := tracker.Active(start.Add(4 * time.Hour)); len(got) != 2 {
+		t.Errorf("Active() within the longest window = %v, want both posts", got)
+	}
+
+	// Once the longest window has passed, a post is no longer active even
+	// before anything else is recorded.
+	if got := tracker.Active(start.Add(6 * time.Hour)); len(got) != 1 || got[0] != "new" {
+		t.Errorf("Active() after the window = %v, want only new", got)
+	}
+	if _, ok := tracker.posts["old"]; !ok {
+		t.Fatal("old post swept before a later record")
+	}
+
+	// The next record in a later hour sweeps it away.
+	tracker.Record("new", View, 1, start.Add(7*time.Hour))
+	if _, ok := tracker.posts["old"]; ok {
+		t.Error("expired post kept after a sweep")
+	}
+	if got := len(tracker.posts["new"].buckets); got != 2 {
+		t.Errorf("new post keeps %d buckets, want 2", got)
+	}
+}
@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryServerInterceptor rejects requests that violate their field rules
+// with INVALID_ARGUMENT. All violations are listed in the message and as
+// BadRequest field violations in the status details.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := Validate(msg); err != nil {
+				return nil, invalidArgument(err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func invalidArgument(err error) error {
+	var verr *Error
+	if !errors.As(err, &verr) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	br := &errdetails.BadRequest{}
+	for _, v := range verr.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, verr.Error())
+	if detailed, detailErr := st.WithDetails(br); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// StreamServerInterceptor validates every message received on a stream.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		if err := Validate(msg); err != nil {
+			return invalidArgument(err)
+		}
+	}
+	return nil
+}
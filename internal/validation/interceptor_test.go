@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/blog.BlogService/UpdatePost"}
+
+	var called bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+
+	_, err := interceptor(context.Background(), &proto.UpdatePostRequest{Content: "content"}, info, handler)
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("interceptor code = %v, want InvalidArgument", st.Code())
+	}
+	if called {
+		t.Error("handler should not run for invalid requests")
+	}
+
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	if len(fields) != 2 || fields[0] != "post_id" || fields[1] != "title" {
+		t.Errorf("field violations = %v, want [post_id title]", fields)
+	}
+
+	valid := &proto.UpdatePostRequest{PostId: "id", Title: "title", Content: "content"}
+	if _, err := interceptor(context.Background(), valid, info, handler); err != nil || !called {
+		t.Errorf("interceptor error = %v, handler called = %v", err, called)
+	}
+}
@@ -0,0 +1,204 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	pb "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation describes one field that failed its rules.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// Error reports every violation found in a message.
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Field + ": " + v.Description
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Validate checks msg against the (blog.rules) options declared on its
+// fields in the proto definitions, descending into nested messages. It
+// returns an *Error listing all violations, or nil.
+func Validate(msg proto.Message) error {
+	var violations []Violation
+	validateMessage(msg.ProtoReflect(), "", &violations)
+	if len(violations) == 0 {
+		return nil
+	}
+	return &Error{Violations: violations}
+}
+
+func validateMessage(m protoreflect.Message, prefix string, violations *[]Violation) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := prefix + string(fd.Name())
+
+		add := func(format string, args ...interface{}) {
+			*violations = append(*violations, Violation{Field: name, Description: fmt.Sprintf(format, args...)})
+		}
+
+		rules := fieldRules(fd)
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			validateList(list, fd, rules, add)
+			if fd.Kind() == protoreflect.MessageKind {
+				for j := 0; j < list.Len(); j++ {
+					validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", name, j), violations)
+				}
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			s := m.Get(fd).String()
+			if !utf8.ValidString(s) {
+				add("must be valid UTF-8")
+				continue
+			}
+			if rules.GetRequired() && s == "" {
+				add("is required")
+				continue
+			}
+			validateString(s, rules.GetMaxLen(), rules.GetMaxBytes(), rules.GetPattern(), add)
+		case isNumber(fd.Kind()):
+			validateNumber(numberValue(m.Get(fd), fd.Kind()), rules, add)
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			if !m.Has(fd) {
+				if rules.GetRequired() {
+					add("is required")
+				}
+				continue
+			}
+			validateMessage(m.Get(fd).Message(), name+".", violations)
+		}
+	}
+}
+
+func validateList(list protoreflect.List, fd protoreflect.FieldDescriptor, rules *pb.FieldRules, add func(string, ...interface{})) {
+	if rules.GetRequired() && list.Len() == 0 {
+		add("is required")
+		return
+	}
+	if max := rules.GetMaxItems(); max > 0 && uint32(list.Len()) > max {
+		add("must have at most %d items, got %d", max, list.Len())
+	}
+	if fd.Kind() != protoreflect.StringKind {
+		return
+	}
+
+	seen := make(map[string]bool, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		s := list.Get(i).String()
+		itemAdd := func(format string, args ...interface{}) {
+			add("item %d %q "+format, append([]interface{}{i, truncate(s)}, args...)...)
+		}
+		if !utf8.ValidString(s) {
+			add("item %d must be valid UTF-8", i)
+			continue
+		}
+		if s == "" {
+			itemAdd("must not be empty")
+			continue
+		}
+		validateString(s, rules.GetItemMaxLen(), 0, rules.GetItemPattern(), itemAdd)
+		if rules.GetUniqueItems() {
+			if seen[s] {
+				itemAdd("is a duplicate")
+			}
+			seen[s] = true
+		}
+	}
+}
+
+func validateString(s string, maxLen, maxBytes uint32, pattern string, add func(string, ...interface{})) {
+	if maxLen > 0 {
+		if n := utf8.RuneCountInString(s); uint32(n) > maxLen {
+			add("must be at most %d characters, got %d", maxLen, n)
+		}
+	}
+	if maxBytes > 0 && uint32(len(s)) > maxBytes {
+		add("must be at most %d bytes, got %d", maxBytes, len(s))
+	}
+	if pattern != "" && s != "" && !compile(pattern).MatchString(s) {
+		add("must match %s", pattern)
+	}
+}
+
+func validateNumber(n float64, rules *pb.FieldRules, add func(string, ...interface{})) {
+	if rules == nil {
+		return
+	}
+	if rules.Min != nil && n < *rules.Min {
+		add("must be at least %v, got %v", *rules.Min, n)
+	}
+	if rules.Max != nil && n > *rules.Max {
+		add("must be at most %v, got %v", *rules.Max, n)
+	}
+}
+
+func isNumber(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		return true
+	}
+	return false
+}
+
+func numberValue(v protoreflect.Value, kind protoreflect.Kind) float64 {
+	switch kind {
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(v.Uint())
+	}
+	return float64(v.Int())
+}
+
+func fieldRules(fd protoreflect.FieldDescriptor) *pb.FieldRules {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, pb.E_Rules) {
+		return nil
+	}
+	return proto.GetExtension(opts, pb.E_Rules).(*pb.FieldRules)
+}
+
+var patterns sync.Map
+
+// compile returns the compiled form of a pattern from the proto options.
+// Patterns are fixed at build time, so an invalid one is a programming
+// error and panics.
+func compile(pattern string) *regexp.Regexp {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+	re := regexp.MustCompile(pattern)
+	patterns.Store(pattern, re)
+	return re
+}
+
+// truncate keeps long values from bloating error messages.
+func truncate(s string) string {
+	const max = 32
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max]) + "..."
+}
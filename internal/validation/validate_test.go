@@ -0,0 +1,170 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	proto "github.com/kpauljoseph/test/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func validCreateRequest() *proto.CreatePostRequest {
+	return &proto.CreatePostRequest{
+		Title:           "Test Post",
+		Content:         "This is test content",
+		Author:          "Test Author",
+		PublicationDate: timestamppb.New(time.Now()),
+		Tags:            []string{"golang", "best-practices"},
+	}
+}
+
+func TestValidate_CreatePostRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		modify     func(*proto.CreatePostRequest)
+		wantFields []string
+	}{
+		{
+			name:       "valid request",
+			modify:     func(r *proto.CreatePostRequest) {},
+			wantFields: nil,
+		},
+		{
+			name:       "author is optional",
+			modify:     func(r *proto.CreatePostRequest) { r.Author = "" },
+			wantFields: nil,
+		},
+		{
+			name: "reports all missing fields at once",
+			modify: func(r *proto.CreatePostRequest) {
+				r.Title = ""
+				r.Content = ""
+				r.PublicationDate = nil
+			},
+			wantFields: []string{"title", "content", "publication_date"},
+		},
+		{
+			name:       "title too long",
+			modify:     func(r *proto.CreatePostRequest) { r.Title = strings.Repeat("é", 201) },
+			wantFields: []string{"title"},
+		},
+		{
+			name:       "content too large",
+			modify:     func(r *proto.CreatePostRequest) { r.Content = strings.Repeat("x", 102401) },
+			wantFields: []string{"content"},
+		},
+		{
+			name:       "invalid UTF-8",
+			modify:     func(r *proto.CreatePostRequest) { r.Title = "bad \xff title" },
+			wantFields: []string{"title"},
+		},
+		{
+			name:       "author format",
+			modify:     func(r *proto.CreatePostRequest) { r.Author = "<script>" },
+			wantFields: []string{"author"},
+		},
+		{
+			name: "tag rules",
+			modify: func(r *proto.CreatePostRequest) {
+				r.Tags = []string{"ok", "has space", "", "ok", strings.Repeat("t", 51)}
+			},
+			wantFields: []string{"tags", "tags", "tags", "tags"},
+		},
+		{
+			name: "too many tags",
+			modify: func(r *proto.CreatePostRequest) {
+				r.Tags = []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}
+			},
+			wantFields: []string{"tags"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validCreateRequest()
+			tt.modify(req)
+
+			err := Validate(req)
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+
+			var verr *Error
+			if !errors.As(err, &verr) {
+				t.Fatalf("Validate() error = %v, want *Error", err)
+			}
+			var got []string
+			for _, v := range verr.Violations {
+				got = append(got, v.Field)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("Validate() fields = %v, want %v (%v)", got, tt.wantFields, err)
+			}
+		})
+	}
+}
+
+func TestValidate_PostIDRequired(t *testing.T) {
+	if err := Validate(&proto.ReadPostRequest{}); err == nil {
+		t.Error("Validate() expected error for missing post_id")
+	}
+	if err := Validate(&proto.DeletePostRequest{PostId: "abc"}); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+}
+
+func TestValidate_MessagesWithoutRules(t *testing.T) {
+	if err := Validate(&proto.ListApiKeysRequest{}); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+}
+
+func TestValidate_RepeatedMessages(t *testing.T) {
+	req := &proto.UpdateAuthorRequest{
+		AuthorId:    "alice",
+		DisplayName: "Alice",
+		SocialLinks: []*proto.SocialLink{
+			{Platform: "github", Url: "https://github.com/alice"},
+			{Platform: "", Url: "javascript:alert(1)"},
+		},
+	}
+
+	var verr *Error
+	if err := Validate(req); !errors.As(err, &verr) {
+		t.Fatalf("Validate() error = %v, want *Error", err)
+	}
+	var got []string
+	for _, v := range verr.Violations {
+		got = append(got, v.Field)
+	}
+	if want := "social_links[1].platform,social_links[1].url"; strings.Join(got, ",") != want {
+		t.Errorf("Validate() fields = %v, want %s", got, want)
+	}
+}
+
+func TestValidate_NumberBounds(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold float64
+		wantErr   bool
+	}{
+		{name: "lower bound", threshold: 0, wantErr: false},
+		{name: "upper bound", threshold: 1, wantErr: false},
+		{name: "below minimum", threshold: -0.1, wantErr: true},
+		{name: "above maximum", threshold: 1.5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&proto.FindDuplicatesRequest{Threshold: tt.threshold})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config controls how deliveries are attempted.
+//
+// A failed delivery is retried after InitialBackoffSeconds, doubling after
+// each further failure up to MaxBackoffSeconds. After MaxAttempts failed
+// attempts it moves to the dead-letter list. HistorySize is the number of
+// recent deliveries kept per webhook. AllowPrivateNetworks permits webhooks
+// on loopback, link-local and private addresses, which are otherwise
+// refused so that webhooks cannot reach the server's own network.
+type Config struct {
+	MaxAttempts           int     `json:"max_attempts"`
+	InitialBackoffSeconds float64 `json:"initial_backoff_seconds"`
+	MaxBackoffSeconds     float64 `json:"max_backoff_seconds"`
+	TimeoutSeconds        float64 `json:"timeout_seconds"`
+	Workers               int     `json:"workers"`
+	HistorySize           int     `json:"history_size"`
+	AllowPrivateNetworks  bool    `json:"allow_private_networks"`
+}
+
+// DefaultConfig returns retries spread over roughly half an hour.
+func DefaultConfig() *Config {
+	return &Config{
+		MaxAttempts:           8,
+		InitialBackoffSeconds: 1,
+		MaxBackoffSeconds:     600,
+		TimeoutSeconds:        10,
+		Workers:               4,
+		HistorySize:           100,
+	}
+}
+
+// LoadConfig reads a JSON webhook delivery configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read webhook config: %w", err)
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse webhook config: %w", err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (c *Config) validate() error {
+	if c.MaxAttempts < 1 {
+		return fmt.Errorf("max_attempts must be at least 1")
+	}
+	if c.InitialBackoffSeconds <= 0 || c.MaxBackoffSeconds < c.InitialBackoffSeconds {
+		return fmt.Errorf("backoff must be positive with max_backoff_seconds at least initial_backoff_seconds")
+	}
+	if c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeout_seconds must be positive")
+	}
+	if c.Workers < 1 {
+		return fmt.Errorf("workers must be at least 1")
+	}
+	if c.HistorySize < 1 {
+		return fmt.Errorf("history_size must be at least 1")
+	}
+	return nil
+}
+
+// backoff returns how long to wait after the given number of failed
+// attempts.
+func (c *Config) backoff(attempts int) time.Duration {
+	seconds := c.InitialBackoffSeconds
+	for i := 1; i < attempts && seconds < c.MaxBackoffSeconds; i++ {
+		seconds *= 2
+	}
+	seconds = min(seconds, c.MaxBackoffSeconds)
+	return time.Duration(seconds * float64(time.Second))
+}
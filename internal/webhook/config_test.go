@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name:    "valid config",
+			data:    `{"max_attempts": 5, "initial_backoff_seconds": 2, "max_backoff_seconds": 60, "timeout_seconds": 5, "workers": 2, "history_size": 50}`,
+			wantErr: false,
+		},
+		{
+			name:    "no attempts",
+			data:    `{"max_attempts": 0, "initial_backoff_seconds": 2, "max_backoff_seconds": 60, "timeout_seconds": 5, "workers": 2, "history_size": 50}`,
+			wantErr: true,
+		},
+		{
+			name:    "max backoff below initial",
+			data:    `{"max_attempts": 5, "initial_backoff_seconds": 10, "max_backoff_seconds": 5, "timeout_seconds": 5, "workers": 2, "history_size": 50}`,
+			wantErr: true,
+		},
+		{
+			name:    "no workers",
+			data:    `{"max_attempts": 5, "initial_backoff_seconds": 2, "max_backoff_seconds": 60, "timeout_seconds": 5, "history_size": 50}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			data:    `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "webhook.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatalf("Failed to write config: %v", err)
+			}
+
+			_, err := LoadConfig(path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_Backoff(t *testing.T) {
+	c := &Config{InitialBackoffSeconds: 1, MaxBackoffSeconds: 5}
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 5 * time.Second},
+		{attempts: 20, want: 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := c.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kpauljoseph/test/internal/outbox"
+)
+
+// maxDeadLetters bounds the dead-letter list; the oldest entries are
+// dropped first.
+const maxDeadLetters = 1000
+
+// idleWait is how long Run sleeps when nothing is scheduled. New work wakes
+// it earlier.
+const idleWait = time.Minute
+
+// ErrNotFound is returned for unknown webhook or delivery IDs.
+var ErrNotFound = errors.New("not found")
+
+// Subscription is a registered webhook. Secret signs its deliveries.
+type Subscription struct {
+	ID         string
+	URL        string
+	EventTypes []string
+	Secret     string
+	CreatedAt  time.Time
+}
+
+// DeliveryState is where a delivery is in its life cycle.
+type DeliveryState int
+
+const (
+	StatePending DeliveryState = iota
+	StateSucceeded
+	StateDead
+)
+
+// Delivery is one event sent to one webhook, with the outcome of its most
+// recent attempt.
+type Delivery struct {
+	ID             string
+	WebhookID      string
+	EventID        string
+	EventType      string
+	PostID         string
+	State          DeliveryState
+	Attempts       int
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	LastAttemptAt  time.Time
+	NextAttemptAt  time.Time
+}
+
+type delivery struct {
+	Delivery
+	body     []byte
+	inFlight bool
+}
+
+// Dispatcher delivers events to subscribed webhooks in the background,
+// retrying failures with exponential backoff. Deliveries that exhaust their
+// attempts are kept on a dead-letter list from which they can be retried.
+type Dispatcher struct {
+	config *Config
+	client *http.Client
+	now    func() time.Time
+	wake   chan struct{}
+
+	mu            sync.Mutex
+	subscriptions map[string]*Subscription
+	pending       []*delivery
+	history       map[string][]*delivery
+	deadLetters   []*delivery
+}
+
+func NewDispatcher(config *Config) *Dispatcher {
+	return &Dispatcher{
+		config:        config,
+		client:        newClient(time.Duration(config.TimeoutSeconds*float64(time.Second)), config.AllowPrivateNetworks),
+		now:           time.Now,
+		wake:          make(chan struct{}, 1),
+		subscriptions: make(map[string]*Subscription),
+		history:       make(map[string][]*delivery),
+	}
+}
+
+// Subscribe registers a webhook for the given event types.
+func (d *Dispatcher) Subscribe(rawURL string, eventTypes []string, secret string) (Subscription, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Subscription{}, fmt.Errorf("url must be an absolute http or https URL")
+	}
+	if !d.config.AllowPrivateNetworks {
+		if err := checkURL(u); err != nil {
+			return Subscription{}, err
+		}
+	}
+	if len(eventTypes) == 0 {
+		return Subscription{}, fmt.Errorf("at least one event type is required")
+	}
+	for _, t := range eventTypes {
+		if !slices.Contains(outbox.EventTypes, t) {
+			return Subscription{}, fmt.Errorf("unknown event type %q", t)
+		}
+	}
+	if secret == "" {
+		return Subscription{}, fmt.Errorf("secret is required")
+	}
+
+	sub := &Subscription{
+		ID:         uuid.New().String(),
+		URL:        rawURL,
+		EventTypes: slices.Compact(slices.Sorted(slices.Values(eventTypes))),
+		Secret:     secret,
+		CreatedAt:  d.now(),
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscriptions[sub.ID] = sub
+	return redact(sub), nil
+}
+
+// Unsubscribe removes a webhook and drops its pending deliveries and
+// history.
+func (d *Dispatcher) Unsubscribe(id string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.subscriptions[id]; !ok {
+		return fmt.Errorf("webhook %s: %w", id, ErrNotFound)
+	}
+	delete(d.subscriptions, id)
+	delete(d.history, id)
+	d.pending = slices.DeleteFunc(d.pending, func(dl *delivery) bool { return dl.WebhookID == id })
+	d.deadLetters = slices.DeleteFunc(d.deadLetters, func(dl *delivery) bool { return dl.WebhookID == id })
+	return nil
+}
+
+// Subscriptions returns all webhooks, oldest first, without their secrets.
+func (d *Dispatcher) Subscriptions() []Subscription {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result := make([]Subscription, 0, len(d.subscriptions))
+	for _, sub := range d.subscriptions {
+		result = append(result, redact(sub))
+	}
+	slices.SortFunc(result, func(a, b Subscription) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return result
+}
+
+// Deliver queues e for every webhook subscribed to its type, which makes
+// the dispatcher an outbox sink. Deliveries are due at e.OccurredAt, so
+// events dated in the future wait until then. Deleting a post cancels its pending publication. An event
+// delivered again by the relay is not queued again for webhooks whose
+// recent history already has it.
+func (d *Dispatcher) Deliver(ctx context.Context, e outbox.Event) error {
+	body, err := e.Payload()
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	if e.Type == outbox.EventPostDeleted {
+		d.pending = slices.DeleteFunc(d.pending, func(dl *delivery) bool {
+			cancelled := !dl.inFlight && dl.Attempts == 0 && dl.PostID == e.PostID && dl.EventType == outbox.EventPostPublished && dl.NextAttemptAt.After(now)
+			if cancelled {
+				d.history[dl.WebhookID] = slices.DeleteFunc(d.history[dl.WebhookID], func(h *delivery) bool { return h == dl })
+			}
+			return cancelled
+		})
+	}
+
+	due := now
+	if e.OccurredAt.After(now) {
+		due = e.OccurredAt
+	}
+	for _, sub := range d.subscriptions {
+		if !slices.Contains(sub.EventTypes, e.Type) {
+			continue
+		}
+		if slices.ContainsFunc(d.history[sub.ID], func(dl *delivery) bool { return dl.EventID == e.ID }) {
+			continue
+		}
+		dl := &delivery{
+			Delivery: Delivery{
+				ID:            uuid.New().String(),
+				WebhookID:     sub.ID,
+				EventID:       e.ID,
+				EventType:     e.Type,
+				PostID:        e.PostID,
+				State:         StatePending,
+				CreatedAt:     now,
+				NextAttemptAt: due,
+			},
+			body: body,
+		}
+		d.pending = append(d.pending, dl)
+		d.record(dl)
+	}
+	d.notify()
+	return nil
+}
+
+// Deliveries returns the recent deliveries of a webhook, newest first.
+func (d *Dispatcher) Deliveries(webhookID string) ([]Delivery, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.subscriptions[webhookID]; !ok {
+		return nil, fmt.Errorf("webhook %s: %w", webhookID, ErrNotFound)
+	}
+	history := d.history[webhookID]
+	result := make([]Delivery, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		result = append(result, history[i].Delivery)
+	}
+	return result, nil
+}
+
+// DeadLetters returns the deliveries that ran out of attempts, newest
+// first.
+func (d *Dispatcher) DeadLetters() []Delivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result := make([]Delivery, 0, len(d.deadLetters))
+	for i := len(d.deadLetters) - 1; i >= 0; i-- {
+		result = append(result, d.deadLetters[i].Delivery)
+	}
+	return result
+}
+
+// Redeliver moves a dead letter back to the queue with a fresh set of
+// attempts.
+func (d *Dispatcher) Redeliver(deliveryID string) (Delivery, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	i := slices.IndexFunc(d.deadLetters, func(dl *delivery) bool { return dl.ID == deliveryID })
+	if i < 0 {
+		return Delivery{}, fmt.Errorf("dead letter %s: %w", deliveryID, ErrNotFound)
+	}
+	dl := d.deadLetters[i]
+	d.deadLetters = slices.Delete(d.deadLetters, i, i+1)
+
+	dl.State = StatePending
+	dl.Attempts = 0
+	dl.NextAttemptAt = d.now()
+	d.pending = append(d.pending, dl)
+	d.notify()
+	return dl.Delivery, nil
+}
+
+// Run delivers due events until ctx is cancelled, with at most
+// config.Workers requests in flight.
+func (d *Dispatcher) Run(ctx context.Context) {
+	workers := make(chan struct{}, d.config.Workers)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		due, wait := d.takeDue()
+		for _, dl := range due {
+			select {
+			case workers <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-workers }()
+				d.attempt(ctx, dl)
+			}()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-d.wake:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// takeDue marks the due deliveries as in flight and returns them along with
+// the time until the next one is due.
+func (d *Dispatcher) takeDue() ([]*delivery, time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	wait := idleWait
+	var due []*delivery
+	for _, dl := range d.pending {
+		if dl.inFlight {
+			continue
+		}
+		if !dl.NextAttemptAt.After(now) {
+			dl.inFlight = true
+			due = append(due, dl)
+		} else if until := dl.NextAttemptAt.Sub(now); until < wait {
+			wait = until
+		}
+	}
+	return due, wait
+}
+
+// attempt sends dl once and reschedules it, completes it or moves it to
+// the dead-letter list depending on the outcome.
+func (d *Dispatcher) attempt(ctx context.Context, dl *delivery) {
+	d.mu.Lock()
+	sub, ok := d.subscriptions[dl.WebhookID]
+	var target, secret string
+	if ok {
+		target, secret = sub.URL, sub.Secret
+	}
+	d.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	status, err := d.send(ctx, target, secret, dl)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.subscriptions[dl.WebhookID]; !ok {
+		return
+	}
+	now := d.now()
+	dl.inFlight = false
+	dl.Attempts++
+	dl.LastAttemptAt = now
+	dl.LastStatusCode = status
+	dl.LastError = ""
+	if err == nil && status >= 200 && status < 300 {
+		dl.State = StateSucceeded
+		d.remove(dl)
+		return
+	}
+	if err != nil {
+		dl.LastError = err.Error()
+	} else {
+		dl.LastError = http.StatusText(status)
+	}
+	if ctx.Err() != nil {
+		// Shutting down; the attempt did not count against the receiver.
+		dl.Attempts--
+		return
+	}
+
+	if dl.Attempts >= d.config.MaxAttempts {
+		slog.Warn("Webhook delivery failed permanently", "delivery_id", dl.ID, "webhook_id", dl.WebhookID, "attempts", dl.Attempts, "error", dl.LastError)
+		dl.State = StateDead
+		d.remove(dl)
+		d.deadLetters = append(d.deadLetters, dl)
+		if len(d.deadLetters) > maxDeadLetters {
+			d.deadLetters = d.deadLetters[len(d.deadLetters)-maxDeadLetters:]
+		}
+		return
+	}
+	dl.NextAttemptAt = now.Add(d.config.backoff(dl.Attempts))
+	d.notify()
+}
+
+func (d *Dispatcher) send(ctx context.Context, target, secret string, dl *delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(dl.body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := d.now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, dl.EventType)
+	req.Header.Set(DeliveryHeader, dl.ID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, dl.body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return resp.StatusCode, nil
+}
+
+// record adds dl to its webhook's history. The caller must hold the lock.
+func (d *Dispatcher) record(dl *delivery) {
+	history := append(d.history[dl.WebhookID], dl)
+	if len(history) > d.config.HistorySize {
+		history = history[len(history)-d.config.HistorySize:]
+	}
+	d.history[dl.WebhookID] = history
+}
+
+// remove drops dl from the pending queue. The caller must hold the lock.
+func (d *Dispatcher) remove(dl *delivery) {
+	d.pending = slices.DeleteFunc(d.pending, func(p *delivery) bool { return p == dl })
+}
+
+// notify wakes Run without blocking.
+func (d *Dispatcher) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func redact(sub *Subscription) Subscription {
+	result := *sub
+	result.Secret = ""
+	return result
+}
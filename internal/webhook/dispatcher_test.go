@@ -0,0 +1,231 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/outbox"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+type receiver struct {
+	server   *httptest.Server
+	failures atomic.Int32
+
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+// newReceiver starts a server that answers 500 while failures is positive,
+// counting it down, and 204 afterwards.
+func newReceiver(t *testing.T, failures int) *receiver {
+	r := &receiver{}
+	r.failures.Store(int32(failures))
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+		r.mu.Unlock()
+		if r.failures.Add(-1) >= 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *receiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func testConfig() *Config {
+	return &Config{
+		MaxAttempts:           3,
+		InitialBackoffSeconds: 0.001,
+		MaxBackoffSeconds:     0.01,
+		TimeoutSeconds:        5,
+		Workers:               2,
+		HistorySize:           10,
+		// Test receivers listen on loopback.
+		AllowPrivateNetworks: true,
+	}
+}
+
+func startDispatcher(t *testing.T, config *Config) *Dispatcher {
+	d := NewDispatcher(config)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return d
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func lastDelivery(t *testing.T, d *Dispatcher, webhookID string) Delivery {
+	t.Helper()
+	deliveries, err := d.Deliveries(webhookID)
+	if err != nil || len(deliveries) == 0 {
+		t.Fatalf("Deliveries() = %v, %v", deliveries, err)
+	}
+	return deliveries[0]
+}
+
+func TestDispatcher_Subscribe(t *testing.T) {
+	d := NewDispatcher(testConfig())
+	tests := []struct {
+		name       string
+		url        string
+		eventTypes []string
+		secret     string
+		wantErr    bool
+	}{
+		{name: "valid", url: "https://example.com/hook", eventTypes: []string{outbox.EventPostCreated}, secret: "s"},
+		{name: "relative url", url: "/hook", eventTypes: []string{outbox.EventPostCreated}, secret: "s", wantErr: true},
+		{name: "unsupported scheme", url: "ftp://example.com/hook", eventTypes: []string{outbox.EventPostCreated}, secret: "s", wantErr: true},
+		{name: "no event types", url: "https://example.com/hook", secret: "s", wantErr: true},
+		{name: "unknown event type", url: "https://example.com/hook", eventTypes: []string{"post.liked"}, secret: "s", wantErr: true},
+		{name: "no secret", url: "https://example.com/hook", eventTypes: []string{outbox.EventPostCreated}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, err := d.Subscribe(tt.url, tt.eventTypes, tt.secret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Subscribe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && sub.Secret != "" {
+				t.Error("Subscribe() returned the secret")
+			}
+		})
+	}
+
+	subs := d.Subscriptions()
+	if len(subs) != 1 || subs[0].Secret != "" {
+		t.Fatalf("Subscriptions() = %v, want one webhook without its secret", subs)
+	}
+	if err := d.Unsubscribe(subs[0].ID); err != nil {
+		t.Errorf("Unsubscribe() error = %v", err)
+	}
+	if err := d.Unsubscribe(subs[0].ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Unsubscribe() twice error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestDispatcher_DeliversSignedPayloads(t *testing.T) {
+	r := newReceiver(t, 0)
+	d := startDispatcher(t, testConfig())
+	created, _ := d.Subscribe(r.server.URL, []string{outbox.EventPostCreated}, "created-secret")
+	deleted, _ := d.Subscribe(r.server.URL, []string{outbox.EventPostDeleted}, "deleted-secret")
+
+	event := outbox.NewEvent(outbox.EventPostCreated, &proto.BlogPost{PostId: "post-1", Title: "Hello"}, time.Now())
+	if err := d.Deliver(context.Background(), event); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	waitFor(t, "delivery", func() bool { return lastDelivery(t, d, created.ID).State == StateSucceeded })
+
+	// The outbox relay may deliver an event more than once.
+	d.Deliver(context.Background(), event)
+	if deliveries, _ := d.Deliveries(created.ID); len(deliveries) != 1 {
+		t.Errorf("Deliveries() after a repeated event = %v, want 1", deliveries)
+	}
+
+	if r.count() != 1 {
+		t.Fatalf("receiver got %d requests, want 1", r.count())
+	}
+	req, body := r.requests[0], r.bodies[0]
+	if req.Header.Get(EventHeader) != outbox.EventPostCreated || req.Header.Get(DeliveryHeader) == "" {
+		t.Errorf("headers = %v", req.Header)
+	}
+	if !Verify("created-secret", req.Header.Get(TimestampHeader), req.Header.Get(SignatureHeader), body) {
+		t.Error("signature does not verify")
+	}
+
+	got := lastDelivery(t, d, created.ID)
+	if got.EventID != event.ID || got.Attempts != 1 || got.LastStatusCode != http.StatusNoContent {
+		t.Errorf("delivery = %+v", got)
+	}
+	if deliveries, _ := d.Deliveries(deleted.ID); len(deliveries) != 0 {
+		t.Errorf("unsubscribed event type was delivered: %v", deliveries)
+	}
+}
+
+func TestDispatcher_RetriesWithBackoff(t *testing.T) {
+	r := newReceiver(t, 2)
+	d := startDispatcher(t, testConfig())
+	sub, _ := d.Subscribe(r.server.URL, []string{outbox.EventPostUpdated}, "secret")
+
+	d.Deliver(context.Background(), outbox.NewEvent(outbox.EventPostUpdated, &proto.BlogPost{PostId: "post-1"}, time.Now()))
+	waitFor(t, "delivery", func() bool { return lastDelivery(t, d, sub.ID).State == StateSucceeded })
+
+	if got := lastDelivery(t, d, sub.ID); got.Attempts != 3 || r.count() != 3 {
+		t.Errorf("delivery took %d attempts and %d requests, want 3", got.Attempts, r.count())
+	}
+}
+
+func TestDispatcher_DeadLetters(t *testing.T) {
+	r := newReceiver(t, 3)
+	d := startDispatcher(t, testConfig())
+	sub, _ := d.Subscribe(r.server.URL, []string{outbox.EventPostUpdated}, "secret")
+
+	d.Deliver(context.Background(), outbox.NewEvent(outbox.EventPostUpdated, &proto.BlogPost{PostId: "post-1"}, time.Now()))
+	waitFor(t, "dead letter", func() bool { return len(d.DeadLetters()) == 1 })
+
+	dead := d.DeadLetters()[0]
+	if dead.State != StateDead || dead.Attempts != 3 || dead.LastStatusCode != http.StatusInternalServerError {
+		t.Errorf("dead letter = %+v", dead)
+	}
+
+	if _, err := d.Redeliver("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Redeliver() of unknown delivery error = %v, want ErrNotFound", err)
+	}
+	if _, err := d.Redeliver(dead.ID); err != nil {
+		t.Fatalf("Redeliver() error = %v", err)
+	}
+	waitFor(t, "redelivery", func() bool { return lastDelivery(t, d, sub.ID).State == StateSucceeded })
+	if len(d.DeadLetters()) != 0 {
+		t.Errorf("DeadLetters() after redelivery = %v, want none", d.DeadLetters())
+	}
+}
+
+func TestDispatcher_DeleteCancelsScheduledPublication(t *testing.T) {
+	d := NewDispatcher(testConfig())
+	sub, _ := d.Subscribe("https://example.com/hook", []string{outbox.EventPostPublished, outbox.EventPostDeleted}, "secret")
+
+	post := &proto.BlogPost{PostId: "post-1"}
+	d.Deliver(context.Background(), outbox.NewEvent(outbox.EventPostPublished, post, time.Now().Add(time.Hour)))
+	d.Deliver(context.Background(), outbox.NewEvent(outbox.EventPostPublished, &proto.BlogPost{PostId: "post-2"}, time.Now().Add(time.Hour)))
+	d.Deliver(context.Background(), outbox.NewDeletedEvent(post.PostId, time.Now()))
+
+	deliveries, _ := d.Deliveries(sub.ID)
+	if len(deliveries) != 2 || deliveries[0].EventType != outbox.EventPostDeleted || deliveries[1].PostID != "post-2" {
+		t.Errorf("Deliveries() = %+v, want the deletion and the other post's publication", deliveries)
+	}
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, which is not
+// reachable from the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// forbiddenAddr reports whether addr is loopback, link-local, private or
+// otherwise not a public unicast address. Webhooks must not be able to make
+// the server send requests to its own network.
+func forbiddenAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return !addr.IsGlobalUnicast() || addr.IsPrivate() || sharedAddressSpace.Contains(addr)
+}
+
+// checkURL rejects webhook URLs that name a host on a private network.
+// Host names are checked again when deliveries connect, because they may
+// resolve to a different address by then.
+func checkURL(u *url.URL) error {
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("url must not point to a private network address")
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && forbiddenAddr(addr) {
+		return fmt.Errorf("url must not point to a private network address")
+	}
+	return nil
+}
+
+// checkDial refuses connections to forbidden addresses. It runs after the
+// host name is resolved, so it also catches names that resolve to private
+// addresses.
+func checkDial(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("parse dial address %q: %w", address, err)
+	}
+	if forbiddenAddr(addrPort.Addr()) {
+		return fmt.Errorf("refusing to deliver to private network address %s", addrPort.Addr())
+	}
+	return nil
+}
+
+// newClient returns the HTTP client deliveries are sent with. It does not
+// follow redirects, which could otherwise lead a delivery to an address
+// the receiver's URL was not checked against, and unless allowPrivate is
+// set it only connects to public addresses.
+func newClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		dialer.Control = checkDial
+		// A proxy would be dialled instead of the receiver, hiding the
+		// receiver's address from checkDial.
+		transport.Proxy = nil
+	}
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kpauljoseph/test/internal/outbox"
+	proto "github.com/kpauljoseph/test/proto"
+)
+
+func TestForbiddenAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "127.0.0.1", want: true},
+		{addr: "::1", want: true},
+		{addr: "10.1.2.3", want: true},
+		{addr: "172.16.0.1", want: true},
+		{addr: "192.168.1.1", want: true},
+		{addr: "169.254.169.254", want: true},
+		{addr: "fe80::1", want: true},
+		{addr: "fd00::1", want: true},
+		{addr: "100.64.0.1", want: true},
+		{addr: "0.0.0.0", want: true},
+		{addr: "::", want: true},
+		{addr: "::ffff:127.0.0.1", want: true},
+		{addr: "224.0.0.1", want: true},
+		{addr: "93.184.216.34", want: false},
+		{addr: "2606:4700::1111", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := forbiddenAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Errorf("forbiddenAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDispatcher_RejectsPrivateURLs(t *testing.T) {
+	d := NewDispatcher(DefaultConfig())
+	for _, rawURL := range []string{
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"http://api.localhost/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/hook",
+		"http://[::1]/hook",
+		"http://0.0.0.0/hook",
+	} {
+		t.Run(rawURL, func(t *testing.T) {
+			if _, err := d.Subscribe(rawURL, []string{outbox.EventPostCreated}, "secret"); err == nil {
+				t.Errorf("Subscribe(%s) expected error", rawURL)
+			}
+		})
+	}
+	if _, err := d.Subscribe("https://example.com/hook", []string{outbox.EventPostCreated}, "secret"); err != nil {
+		t.Errorf("Subscribe() of a public URL error = %v", err)
+	}
+}
+
+func TestDispatcher_RefusesPrivateAddressesWhenDialling(t *testing.T) {
+	r := newReceiver(t, 0)
+	config := testConfig()
+	config.AllowPrivateNetworks = false
+	config.MaxAttempts = 1
+	d := startDispatcher(t, config)
+
+	// A host name that resolves to a private address passes Subscribe, so
+	// the address is checked again when connecting.
+	sub := &Subscription{ID: "hook", URL: r.server.URL, EventTypes: []string{outbox.EventPostCreated}, Secret: "secret"}
+	d.mu.Lock()
+	d.subscriptions[sub.ID] = sub
+	d.mu.Unlock()
+
+	d.Deliver(context.Background(), outbox.NewEvent(outbox.EventPostCreated, &proto.BlogPost{PostId: "post-1"}, time.Now()))
+	waitFor(t, "dead letter", func() bool { return len(d.DeadLetters()) == 1 })
+
+	if r.count() != 0 {
+		t.Errorf("receiver on a private address got %d requests, want 0", r.count())
+	}
+	if dead := d.DeadLetters()[0]; !strings.Contains(dead.LastError, "private network") {
+		t.Errorf("dead letter error = %q, want a private network refusal", dead.LastError)
+	}
+}
+
+func TestDispatcher_DoesNotFollowRedirects(t *testing.T) {
+	target := newReceiver(t, 0)
+	redirect := httptest.NewServer(http.RedirectHandler(target.server.URL, http.StatusTemporaryRedirect))
+	t.Cleanup(redirect.Close)
+
+	config := testConfig()
+	config.MaxAttempts = 1
+	d := startDispatcher(t, config)
+	sub, _ := d.Subscribe(redirect.URL, []string{outbox.EventPostCreated}, "secret")
+
+	d.Deliver(context.Background(), outbox.NewEvent(outbox.EventPostCreated, &proto.BlogPost{PostId: "post-1"}, time.Now()))
+	waitFor(t, "dead letter", func() bool { return len(d.DeadLetters()) == 1 })
+
+	if target.count() != 0 {
+		t.Errorf("redirect target got %d requests, want 0", target.count())
+	}
+	if got := lastDelivery(t, d, sub.ID); got.LastStatusCode != http.StatusTemporaryRedirect {
+		t.Errorf("delivery status = %d, want %d", got.LastStatusCode, http.StatusTemporaryRedirect)
+	}
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// Headers sent with every delivery. SignatureHeader carries "sha256=" and
+// the hex HMAC-SHA256 of the timestamp, a dot and the body, keyed with the
+// webhook's secret.
+const (
+	EventHeader     = "X-Blog-Event"
+	DeliveryHeader  = "X-Blog-Delivery"
+	TimestampHeader = "X-Blog-Timestamp"
+	SignatureHeader = "X-Blog-Signature"
+)
+
+// Sign returns the SignatureHeader value for body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is valid for body and the
+// TimestampHeader value timestamp. Receivers should also reject old
+// timestamps to limit replays.
+func Verify(secret, timestamp, signature string, body []byte) bool {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	want := Sign(secret, time.Unix(unix, 0), body)
+	return hmac.Equal([]byte(want), []byte(signature))
+}
@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"event-1"}`)
+	signature := Sign("secret", at, body)
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		body      []byte
+		want      bool
+	}{
+		{name: "valid", secret: "secret", timestamp: timestamp, body: body, want: true},
+		{name: "wrong secret", secret: "other", timestamp: timestamp, body: body, want: false},
+		{name: "changed body", secret: "secret", timestamp: timestamp, body: []byte(`{"id":"event-2"}`), want: false},
+		{name: "changed timestamp", secret: "secret", timestamp: "1700000001", body: body, want: false},
+		{name: "malformed timestamp", secret: "secret", timestamp: "soon", body: body, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.timestamp, signature, tt.body); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ package blog
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,16 +23,181 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StatsGranularity int32
+
+const (
+	StatsGranularity_STATS_GRANULARITY_UNSPECIFIED StatsGranularity = 0
+	StatsGranularity_STATS_GRANULARITY_HOURLY      StatsGranularity = 1
+	StatsGranularity_STATS_GRANULARITY_DAILY       StatsGranularity = 2
+)
+
+// Enum value maps for StatsGranularity.
+var (
+	StatsGranularity_name = map[int32]string{
+		0: "STATS_GRANULARITY_UNSPECIFIED",
+		1: "STATS_GRANULARITY_HOURLY",
+		2: "STATS_GRANULARITY_DAILY",
+	}
+	StatsGranularity_value = map[string]int32{
+		"STATS_GRANULARITY_UNSPECIFIED": 0,
+		"STATS_GRANULARITY_HOURLY":      1,
+		"STATS_GRANULARITY_DAILY":       2,
+	}
+)
+
+func (x StatsGranularity) Enum() *StatsGranularity {
+	p := new(StatsGranularity)
+	*p = x
+	return p
+}
+
+func (x StatsGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_proto_enumTypes[0].Descriptor()
+}
+
+func (StatsGranularity) Type() protoreflect.EnumType {
+	return &file_blog_proto_enumTypes[0]
+}
+
+func (x StatsGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsGranularity.Descriptor instead.
+func (StatsGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{0}
+}
+
+type ModerationState int32
+
+const (
+	ModerationState_MODERATION_STATE_UNSPECIFIED ModerationState = 0
+	ModerationState_MODERATION_STATE_PENDING     ModerationState = 1
+	ModerationState_MODERATION_STATE_APPROVED    ModerationState = 2
+	ModerationState_MODERATION_STATE_REJECTED    ModerationState = 3
+	ModerationState_MODERATION_STATE_SPAM        ModerationState = 4
+)
+
+// Enum value maps for ModerationState.
+var (
+	ModerationState_name = map[int32]string{
+		0: "MODERATION_STATE_UNSPECIFIED",
+		1: "MODERATION_STATE_PENDING",
+		2: "MODERATION_STATE_APPROVED",
+		3: "MODERATION_STATE_REJECTED",
+		4: "MODERATION_STATE_SPAM",
+	}
+	ModerationState_value = map[string]int32{
+		"MODERATION_STATE_UNSPECIFIED": 0,
+		"MODERATION_STATE_PENDING":     1,
+		"MODERATION_STATE_APPROVED":    2,
+		"MODERATION_STATE_REJECTED":    3,
+		"MODERATION_STATE_SPAM":        4,
+	}
+)
+
+func (x ModerationState) Enum() *ModerationState {
+	p := new(ModerationState)
+	*p = x
+	return p
+}
+
+func (x ModerationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_proto_enumTypes[1].Descriptor()
+}
+
+func (ModerationState) Type() protoreflect.EnumType {
+	return &file_blog_proto_enumTypes[1]
+}
+
+func (x ModerationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationState.Descriptor instead.
+func (ModerationState) EnumDescriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{1}
+}
+
+type DeliveryState int32
+
+const (
+	DeliveryState_DELIVERY_STATE_UNSPECIFIED DeliveryState = 0
+	DeliveryState_DELIVERY_STATE_PENDING     DeliveryState = 1
+	DeliveryState_DELIVERY_STATE_SUCCEEDED   DeliveryState = 2
+	DeliveryState_DELIVERY_STATE_DEAD        DeliveryState = 3
+)
+
+// Enum value maps for DeliveryState.
+var (
+	DeliveryState_name = map[int32]string{
+		0: "DELIVERY_STATE_UNSPECIFIED",
+		1: "DELIVERY_STATE_PENDING",
+		2: "DELIVERY_STATE_SUCCEEDED",
+		3: "DELIVERY_STATE_DEAD",
+	}
+	DeliveryState_value = map[string]int32{
+		"DELIVERY_STATE_UNSPECIFIED": 0,
+		"DELIVERY_STATE_PENDING":     1,
+		"DELIVERY_STATE_SUCCEEDED":   2,
+		"DELIVERY_STATE_DEAD":        3,
+	}
+)
+
+func (x DeliveryState) Enum() *DeliveryState {
+	p := new(DeliveryState)
+	*p = x
+	return p
+}
+
+func (x DeliveryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_proto_enumTypes[2].Descriptor()
+}
+
+func (DeliveryState) Type() protoreflect.EnumType {
+	return &file_blog_proto_enumTypes[2]
+}
+
+func (x DeliveryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryState.Descriptor instead.
+func (DeliveryState) EnumDescriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{2}
+}
+
 type BlogPost struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PostId          string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content         string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	PostId  string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Display name of the primary author.
 	Author          string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
 	PublicationDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=publication_date,json=publicationDate,proto3" json:"publication_date,omitempty"`
 	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Number of users who left each reaction type.
+	ReactionCounts map[string]int64 `protobuf:"bytes,7,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The post's primary category; empty when it has none.
+	CategoryId string `protobuf:"bytes,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Profile of the primary author.
+	AuthorId string `protobuf:"bytes,9,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Profiles of further authors, who may also edit the post.
+	CoAuthorIds   []string `protobuf:"bytes,10,rep,name=co_author_ids,json=coAuthorIds,proto3" json:"co_author_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BlogPost) Reset() {
@@ -106,6 +272,34 @@ func (x *BlogPost) GetTags() []string {
 	return nil
 }
 
+func (x *BlogPost) GetReactionCounts() map[string]int64 {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+func (x *BlogPost) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *BlogPost) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *BlogPost) GetCoAuthorIds() []string {
+	if x != nil {
+		return x.CoAuthorIds
+	}
+	return nil
+}
+
 type CreatePostRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -113,8 +307,15 @@ type CreatePostRequest struct {
 	Author          string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
 	PublicationDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=publication_date,json=publicationDate,proto3" json:"publication_date,omitempty"`
 	Tags            []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Retries carrying the same key return the originally created post
+	// instead of creating a duplicate. The idempotency-key metadata value is
+	// used when this field is empty.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Primary category of the post; optional.
+	CategoryId    string   `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CoAuthorIds   []string `protobuf:"bytes,8,rep,name=co_author_ids,json=coAuthorIds,proto3" json:"co_author_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePostRequest) Reset() {
@@ -182,12 +383,39 @@ func (x *CreatePostRequest) GetTags() []string {
 	return nil
 }
 
+func (x *CreatePostRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetCoAuthorIds() []string {
+	if x != nil {
+		return x.CoAuthorIds
+	}
+	return nil
+}
+
 type CreatePostResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *BlogPost              `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Post  *BlogPost              `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Error string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Set when the post was returned from an earlier call with the same
+	// idempotency key.
+	Replayed bool `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	// Existing posts with near-duplicate content, when the server warns
+	// about duplicates instead of rejecting them.
+	DuplicateWarnings []*DuplicateMatch `protobuf:"bytes,4,rep,name=duplicate_warnings,json=duplicateWarnings,proto3" json:"duplicate_warnings,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CreatePostResponse) Reset() {
@@ -234,6 +462,73 @@ func (x *CreatePostResponse) GetError() string {
 	return ""
 }
 
+func (x *CreatePostResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+func (x *CreatePostResponse) GetDuplicateWarnings() []*DuplicateMatch {
+	if x != nil {
+		return x.DuplicateWarnings
+	}
+	return nil
+}
+
+type DuplicateMatch struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Estimated share of content in common, between 0 and 1.
+	Similarity    float64 `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateMatch) Reset() {
+	*x = DuplicateMatch{}
+	mi := &file_blog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMatch) ProtoMessage() {}
+
+func (x *DuplicateMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMatch.ProtoReflect.Descriptor instead.
+func (*DuplicateMatch) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DuplicateMatch) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *DuplicateMatch) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
 type ReadPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -243,7 +538,7 @@ type ReadPostRequest struct {
 
 func (x *ReadPostRequest) Reset() {
 	*x = ReadPostRequest{}
-	mi := &file_blog_proto_msgTypes[3]
+	mi := &file_blog_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +550,7 @@ func (x *ReadPostRequest) String() string {
 func (*ReadPostRequest) ProtoMessage() {}
 
 func (x *ReadPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_proto_msgTypes[3]
+	mi := &file_blog_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +563,7 @@ func (x *ReadPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadPostRequest.ProtoReflect.Descriptor instead.
 func (*ReadPostRequest) Descriptor() ([]byte, []int) {
-	return file_blog_proto_rawDescGZIP(), []int{3}
+	return file_blog_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ReadPostRequest) GetPostId() string {
@@ -279,16 +574,18 @@ func (x *ReadPostRequest) GetPostId() string {
 }
 
 type ReadPostResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *BlogPost              `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Post  *BlogPost              `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Error string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Set when the post is part of a series.
+	Series        *SeriesNavigation `protobuf:"bytes,3,opt,name=series,proto3" json:"series,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReadPostResponse) Reset() {
 	*x = ReadPostResponse{}
-	mi := &file_blog_proto_msgTypes[4]
+	mi := &file_blog_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -300,7 +597,7 @@ func (x *ReadPostResponse) String() string {
 func (*ReadPostResponse) ProtoMessage() {}
 
 func (x *ReadPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_proto_msgTypes[4]
+	mi := &file_blog_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +610,7 @@ func (x *ReadPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadPostResponse.ProtoReflect.Descriptor instead.
 func (*ReadPostResponse) Descriptor() ([]byte, []int) {
-	return file_blog_proto_rawDescGZIP(), []int{4}
+	return file_blog_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReadPostResponse) GetPost() *BlogPost {
@@ -330,20 +627,38 @@ func (x *ReadPostResponse) GetError() string {
 	return ""
 }
 
+func (x *ReadPostResponse) GetSeries() *SeriesNavigation {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
 type UpdatePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
-	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	PostId  string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Deprecated and ignored: the primary author of a post does not change.
+	// Use AuthorService.UpdateAuthor to change how the author is shown.
+	Author string   `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Tags   []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Replaces the post's primary category when update_mask lists it; empty
+	// removes it.
+	CategoryId string `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Replaces the post's co-authors when update_mask lists them.
+	CoAuthorIds []string `protobuf:"bytes,7,rep,name=co_author_ids,json=coAuthorIds,proto3" json:"co_author_ids,omitempty"`
+	// Lists which of category_id and co_author_ids to replace. Fields not
+	// listed are left unchanged, so that clients unaware of them do not
+	// clear them. Title, content and tags are always replaced.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePostRequest) Reset() {
 	*x = UpdatePostRequest{}
-	mi := &file_blog_proto_msgTypes[5]
+	mi := &file_blog_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +670,7 @@ func (x *UpdatePostRequest) String() string {
 func (*UpdatePostRequest) ProtoMessage() {}
 
 func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_proto_msgTypes[5]
+	mi := &file_blog_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +683,7 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_blog_proto_rawDescGZIP(), []int{5}
+	return file_blog_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdatePostRequest) GetPostId() string {
@@ -406,6 +721,27 @@ func (x *UpdatePostRequest) GetTags() []string {
 	return nil
 }
 
+func (x *UpdatePostRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetCoAuthorIds() []string {
+	if x != nil {
+		return x.CoAuthorIds
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type UpdatePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Post          *BlogPost              `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
@@ -416,7 +752,7 @@ type UpdatePostResponse struct {
 
 func (x *UpdatePostResponse) Reset() {
 	*x = UpdatePostResponse{}
-	mi := &file_blog_proto_msgTypes[6]
+	mi := &file_blog_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +764,7 @@ func (x *UpdatePostResponse) String() string {
 func (*UpdatePostResponse) ProtoMessage() {}
 
 func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_proto_msgTypes[6]
+	mi := &file_blog_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +777,7 @@ func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
-	return file_blog_proto_rawDescGZIP(), []int{6}
+	return file_blog_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdatePostResponse) GetPost() *BlogPost {
@@ -467,7 +803,7 @@ type DeletePostRequest struct {
 
 func (x *DeletePostRequest) Reset() {
 	*x = DeletePostRequest{}
-	mi := &file_blog_proto_msgTypes[7]
+	mi := &file_blog_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -479,7 +815,7 @@ func (x *DeletePostRequest) String() string {
 func (*DeletePostRequest) ProtoMessage() {}
 
 func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_proto_msgTypes[7]
+	mi := &file_blog_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +828,7 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
 func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_blog_proto_rawDescGZIP(), []int{7}
+	return file_blog_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeletePostRequest) GetPostId() string {
@@ -512,7 +848,7 @@ type DeletePostResponse struct {
 
 func (x *DeletePostResponse) Reset() {
 	*x = DeletePostResponse{}
-	mi := &file_blog_proto_msgTypes[8]
+	mi := &file_blog_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -524,7 +860,7 @@ func (x *DeletePostResponse) String() string {
 func (*DeletePostResponse) ProtoMessage() {}
 
 func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blog_proto_msgTypes[8]
+	mi := &file_blog_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +873,7 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
 func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_blog_proto_rawDescGZIP(), []int{8}
+	return file_blog_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeletePostResponse) GetSuccess() bool {
@@ -554,55 +890,6120 @@ func (x *DeletePostResponse) GetError() string {
 	return ""
 }
 
-var File_blog_proto protoreflect.FileDescriptor
+type ReactToPostRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PostId   string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reaction string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	// Who is reacting. Ignored for authenticated callers.
+	User          string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_blog_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"blog.proto\x12\x04blog\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc6\x01\n" +
-	"\bBlogPost\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
-	"\x06author\x18\x04 \x01(\tR\x06author\x12E\n" +
-	"\x10publication_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0fpublicationDate\x12\x12\n" +
-	"\x04tags\x18\x06 \x03(\tR\x04tags\"\xb6\x01\n" +
-	"\x11CreatePostRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\x16\n" +
-	"\x06author\x18\x03 \x01(\tR\x06author\x12E\n" +
-	"\x10publication_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fpublicationDate\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"N\n" +
-	"\x12CreatePostResponse\x12\"\n" +
-	"\x04post\x18\x01 \x01(\v2\x0e.blog.BlogPostR\x04post\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"*\n" +
-	"\x0fReadPostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\tR\x06postId\"L\n" +
-	"\x10ReadPostResponse\x12\"\n" +
-	"\x04post\x18\x01 \x01(\v2\x0e.blog.BlogPostR\x04post\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\x88\x01\n" +
-	"\x11UpdatePostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
-	"\x06author\x18\x04 \x01(\tR\x06author\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"N\n" +
-	"\x12UpdatePostResponse\x12\"\n" +
-	"\x04post\x18\x01 \x01(\v2\x0e.blog.BlogPostR\x04post\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\",\n" +
-	"\x11DeletePostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\tR\x06postId\"D\n" +
-	"\x12DeletePostResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error2\x8b\x02\n" +
-	"\vBlogService\x12?\n" +
-	"\n" +
-	"CreatePost\x12\x17.blog.CreatePostRequest\x1a\x18.blog.CreatePostResponse\x129\n" +
-	"\bReadPost\x12\x15.blog.ReadPostRequest\x1a\x16.blog.ReadPostResponse\x12?\n" +
-	"\n" +
-	"UpdatePost\x12\x17.blog.UpdatePostRequest\x1a\x18.blog.UpdatePostResponse\x12?\n" +
+func (x *ReactToPostRequest) Reset() {
+	*x = ReactToPostRequest{}
+	mi := &file_blog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactToPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactToPostRequest) ProtoMessage() {}
+
+func (x *ReactToPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactToPostRequest.ProtoReflect.Descriptor instead.
+func (*ReactToPostRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReactToPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ReactToPostRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *ReactToPostRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ReactToPostResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReactionCounts map[string]int64       `protobuf:"bytes,1,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Error          string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReactToPostResponse) Reset() {
+	*x = ReactToPostResponse{}
+	mi := &file_blog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactToPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactToPostResponse) ProtoMessage() {}
+
+func (x *ReactToPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactToPostResponse.ProtoReflect.Descriptor instead.
+func (*ReactToPostResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReactToPostResponse) GetReactionCounts() map[string]int64 {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+func (x *ReactToPostResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RemoveReactionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PostId   string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reaction string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	// Whose reaction to remove. Ignored for authenticated callers.
+	User          string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_blog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveReactionRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type RemoveReactionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReactionCounts map[string]int64       `protobuf:"bytes,1,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Error          string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	mi := &file_blog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveReactionResponse) GetReactionCounts() map[string]int64 {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+func (x *RemoveReactionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RecordViewRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Identifies the viewer for de-duplication on behalf of callers that may
+	// modify any post. Ignored for other callers, who are identified by
+	// their subject or peer address.
+	Viewer        string `protobuf:"bytes,2,opt,name=viewer,proto3" json:"viewer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordViewRequest) Reset() {
+	*x = RecordViewRequest{}
+	mi := &file_blog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordViewRequest) ProtoMessage() {}
+
+func (x *RecordViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordViewRequest.ProtoReflect.Descriptor instead.
+func (*RecordViewRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RecordViewRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *RecordViewRequest) GetViewer() string {
+	if x != nil {
+		return x.Viewer
+	}
+	return ""
+}
+
+type RecordViewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False when the viewer was already counted within the de-duplication
+	// window.
+	Counted       bool   `protobuf:"varint,1,opt,name=counted,proto3" json:"counted,omitempty"`
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordViewResponse) Reset() {
+	*x = RecordViewResponse{}
+	mi := &file_blog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordViewResponse) ProtoMessage() {}
+
+func (x *RecordViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordViewResponse.ProtoReflect.Descriptor instead.
+func (*RecordViewResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecordViewResponse) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+func (x *RecordViewResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetPostStatsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PostId    string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Exclusive.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Defaults to STATS_GRANULARITY_DAILY.
+	Granularity   StatsGranularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=blog.StatsGranularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostStatsRequest) Reset() {
+	*x = GetPostStatsRequest{}
+	mi := &file_blog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostStatsRequest) ProtoMessage() {}
+
+func (x *GetPostStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostStatsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetPostStatsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetPostStatsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetPostStatsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetPostStatsRequest) GetGranularity() StatsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return StatsGranularity_STATS_GRANULARITY_UNSPECIFIED
+}
+
+type ViewBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the hour or UTC day.
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Views         int64                  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewBucket) Reset() {
+	*x = ViewBucket{}
+	mi := &file_blog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewBucket) ProtoMessage() {}
+
+func (x *ViewBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewBucket.ProtoReflect.Descriptor instead.
+func (*ViewBucket) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ViewBucket) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ViewBucket) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+type GetPostStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One bucket per hour or day in the range, including empty ones.
+	Buckets       []*ViewBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TotalViews    int64         `protobuf:"varint,2,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`
+	Error         string        `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostStatsResponse) Reset() {
+	*x = GetPostStatsResponse{}
+	mi := &file_blog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostStatsResponse) ProtoMessage() {}
+
+func (x *GetPostStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostStatsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPostStatsResponse) GetBuckets() []*ViewBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetPostStatsResponse) GetTotalViews() int64 {
+	if x != nil {
+		return x.TotalViews
+	}
+	return 0
+}
+
+func (x *GetPostStatsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListTrendingPostsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restricts the list to posts with this tag, using the tag's trending
+	// window when one is configured.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Maximum number of posts to return; defaults to 10.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingPostsRequest) Reset() {
+	*x = ListTrendingPostsRequest{}
+	mi := &file_blog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingPostsRequest) ProtoMessage() {}
+
+func (x *ListTrendingPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingPostsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTrendingPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListTrendingPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrendingPost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *BlogPost              `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingPost) Reset() {
+	*x = TrendingPost{}
+	mi := &file_blog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingPost) ProtoMessage() {}
+
+func (x *TrendingPost) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingPost.ProtoReflect.Descriptor instead.
+func (*TrendingPost) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TrendingPost) GetPost() *BlogPost {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *TrendingPost) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ListTrendingPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Highest score first.
+	Posts         []*TrendingPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Error         string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingPostsResponse) Reset() {
+	*x = ListTrendingPostsResponse{}
+	mi := &file_blog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingPostsResponse) ProtoMessage() {}
+
+func (x *ListTrendingPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingPostsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListTrendingPostsResponse) GetPosts() []*TrendingPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListTrendingPostsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetRelatedPostsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Maximum number of posts to return; defaults to 5.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedPostsRequest) Reset() {
+	*x = GetRelatedPostsRequest{}
+	mi := &file_blog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedPostsRequest) ProtoMessage() {}
+
+func (x *GetRelatedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRelatedPostsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetRelatedPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RelatedPost struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Post  *BlogPost              `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	// Blend of shared tags, content similarity and same-author affinity.
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedPost) Reset() {
+	*x = RelatedPost{}
+	mi := &file_blog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedPost) ProtoMessage() {}
+
+func (x *RelatedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedPost.ProtoReflect.Descriptor instead.
+func (*RelatedPost) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RelatedPost) GetPost() *BlogPost {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *RelatedPost) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetRelatedPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most related first.
+	Posts         []*RelatedPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Error         string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedPostsResponse) Reset() {
+	*x = GetRelatedPostsResponse{}
+	mi := &file_blog_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedPostsResponse) ProtoMessage() {}
+
+func (x *GetRelatedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRelatedPostsResponse) GetPosts() []*RelatedPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetRelatedPostsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Revoked       bool                   `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_blog_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApiKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_blog_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateApiKeyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The plaintext key. It is only returned once and never stored.
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_blog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateApiKeyResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_blog_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{28}
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_blog_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+func (x *ListApiKeysResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_blog_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeApiKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_blog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RevokeApiKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeApiKeyResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Comment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CommentId string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PostId    string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Empty for top-level comments.
+	ParentId  string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Author    string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Content   string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Deleted comments keep their place in a thread but lose their content.
+	Deleted bool       `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Replies []*Comment `protobuf:"bytes,9,rep,name=replies,proto3" json:"replies,omitempty"`
+	// Only approved comments are shown in ListComments.
+	ModerationState ModerationState `protobuf:"varint,10,opt,name=moderation_state,json=moderationState,proto3,enum=blog.ModerationState" json:"moderation_state,omitempty"`
+	// Why the comment is in its current state, e.g. the rule that held it.
+	ModerationReason string `protobuf:"bytes,11,opt,name=moderation_reason,json=moderationReason,proto3" json:"moderation_reason,omitempty"`
+	// Subject of the editor who last moderated the comment; empty when the
+	// state was set by auto-moderation.
+	ModeratedBy   string `protobuf:"bytes,12,opt,name=moderated_by,json=moderatedBy,proto3" json:"moderated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_blog_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Comment) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *Comment) GetModerationState() ModerationState {
+	if x != nil {
+		return x.ModerationState
+	}
+	return ModerationState_MODERATION_STATE_UNSPECIFIED
+}
+
+func (x *Comment) GetModerationReason() string {
+	if x != nil {
+		return x.ModerationReason
+	}
+	return ""
+}
+
+func (x *Comment) GetModeratedBy() string {
+	if x != nil {
+		return x.ModeratedBy
+	}
+	return ""
+}
+
+type AddCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_blog_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type AddCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentResponse) Reset() {
+	*x = AddCommentResponse{}
+	mi := &file_blog_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentResponse) ProtoMessage() {}
+
+func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *AddCommentResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListCommentsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Maximum number of top-level threads to return; defaults to 20.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_blog_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListCommentsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Top-level comments, oldest first, with their replies nested.
+	Comments      []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Error         string     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_blog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCommentsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type EditCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_blog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EditCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type EditCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentResponse) Reset() {
+	*x = EditCommentResponse{}
+	mi := &file_blog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentResponse) ProtoMessage() {}
+
+func (x *EditCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentResponse.ProtoReflect.Descriptor instead.
+func (*EditCommentResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EditCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *EditCommentResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_blog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_blog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteCommentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteCommentResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListModerationQueueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to MODERATION_STATE_PENDING.
+	State ModerationState `protobuf:"varint,1,opt,name=state,proto3,enum=blog.ModerationState" json:"state,omitempty"`
+	// Maximum number of comments to return; defaults to 20.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueRequest) Reset() {
+	*x = ListModerationQueueRequest{}
+	mi := &file_blog_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListModerationQueueRequest) GetState() ModerationState {
+	if x != nil {
+		return x.State
+	}
+	return ModerationState_MODERATION_STATE_UNSPECIFIED
+}
+
+func (x *ListModerationQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModerationQueueRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListModerationQueueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Comments in the requested state across all posts, oldest first.
+	Comments      []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Error         string     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueResponse) Reset() {
+	*x = ListModerationQueueResponse{}
+	mi := &file_blog_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueResponse) ProtoMessage() {}
+
+func (x *ListModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListModerationQueueResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListModerationQueueResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListModerationQueueResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ModerateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	State         ModerationState        `protobuf:"varint,2,opt,name=state,proto3,enum=blog.ModerationState" json:"state,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentRequest) Reset() {
+	*x = ModerateCommentRequest{}
+	mi := &file_blog_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentRequest) ProtoMessage() {}
+
+func (x *ModerateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ModerateCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *ModerateCommentRequest) GetState() ModerationState {
+	if x != nil {
+		return x.State
+	}
+	return ModerationState_MODERATION_STATE_UNSPECIFIED
+}
+
+func (x *ModerateCommentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModerateCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentResponse) Reset() {
+	*x = ModerateCommentResponse{}
+	mi := &file_blog_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentResponse) ProtoMessage() {}
+
+func (x *ModerateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentResponse.ProtoReflect.Descriptor instead.
+func (*ModerateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ModerateCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *ModerateCommentResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FindDuplicatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Minimum similarity between 0 and 1; defaults to the server's
+	// configured threshold.
+	Threshold     float64 `protobuf:"fixed64,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesRequest) Reset() {
+	*x = FindDuplicatesRequest{}
+	mi := &file_blog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FindDuplicatesRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type DuplicatePair struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PostId          string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	DuplicatePostId string                 `protobuf:"bytes,2,opt,name=duplicate_post_id,json=duplicatePostId,proto3" json:"duplicate_post_id,omitempty"`
+	Similarity      float64                `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DuplicatePair) Reset() {
+	*x = DuplicatePair{}
+	mi := &file_blog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicatePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicatePair) ProtoMessage() {}
+
+func (x *DuplicatePair) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicatePair.ProtoReflect.Descriptor instead.
+func (*DuplicatePair) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DuplicatePair) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *DuplicatePair) GetDuplicatePostId() string {
+	if x != nil {
+		return x.DuplicatePostId
+	}
+	return ""
+}
+
+func (x *DuplicatePair) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type FindDuplicatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most similar first.
+	Pairs         []*DuplicatePair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Error         string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesResponse) Reset() {
+	*x = FindDuplicatesResponse{}
+	mi := &file_blog_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesResponse) ProtoMessage() {}
+
+func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FindDuplicatesResponse) GetPairs() []*DuplicatePair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *FindDuplicatesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TagCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	PostCount     int32                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_blog_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_blog_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{49}
+}
+
+type ListTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most used first.
+	Tags          []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Error         string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_blog_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTagsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RenameTagRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	OldTag string                 `protobuf:"bytes,1,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
+	// Normalized like the tags of new posts.
+	NewTag        string `protobuf:"bytes,2,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_blog_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RenameTagRequest) GetOldTag() string {
+	if x != nil {
+		return x.OldTag
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostsUpdated  int32                  `protobuf:"varint,1,opt,name=posts_updated,json=postsUpdated,proto3" json:"posts_updated,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_blog_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RenameTagResponse) GetPostsUpdated() int32 {
+	if x != nil {
+		return x.PostsUpdated
+	}
+	return 0
+}
+
+func (x *RenameTagResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MergeTagsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SourceTags []string               `protobuf:"bytes,1,rep,name=source_tags,json=sourceTags,proto3" json:"source_tags,omitempty"`
+	// Normalized like the tags of new posts.
+	TargetTag     string `protobuf:"bytes,2,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_blog_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MergeTagsRequest) GetSourceTags() []string {
+	if x != nil {
+		return x.SourceTags
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTargetTag() string {
+	if x != nil {
+		return x.TargetTag
+	}
+	return ""
+}
+
+type MergeTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostsUpdated  int32                  `protobuf:"varint,1,opt,name=posts_updated,json=postsUpdated,proto3" json:"posts_updated,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_blog_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MergeTagsResponse) GetPostsUpdated() int32 {
+	if x != nil {
+		return x.PostsUpdated
+	}
+	return 0
+}
+
+func (x *MergeTagsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SuggestTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What the user has typed so far.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Maximum number of suggestions; defaults to 10.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsRequest) Reset() {
+	*x = SuggestTagsRequest{}
+	mi := &file_blog_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsRequest) ProtoMessage() {}
+
+func (x *SuggestTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTagsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SuggestTagsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SuggestTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TagSuggestion struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Tag       string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	PostCount int32                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	// Edits needed to turn the query into a prefix of the tag; zero for
+	// exact prefix matches.
+	Distance      int32 `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSuggestion) Reset() {
+	*x = TagSuggestion{}
+	mi := &file_blog_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSuggestion) ProtoMessage() {}
+
+func (x *TagSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSuggestion.ProtoReflect.Descriptor instead.
+func (*TagSuggestion) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TagSuggestion) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagSuggestion) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *TagSuggestion) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type SuggestTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exact prefix matches first, then by distance and usage.
+	Suggestions   []*TagSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	Error         string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsResponse) Reset() {
+	*x = SuggestTagsResponse{}
+	mi := &file_blog_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsResponse) ProtoMessage() {}
+
+func (x *SuggestTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestTagsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SuggestTagsResponse) GetSuggestions() []*TagSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *SuggestTagsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Category struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty for top-level categories.
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Names from the top-level category down to this one.
+	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	// Posts in this category and all of its descendants.
+	PostCount     int32 `protobuf:"varint,5,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_blog_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Category) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Category) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+type CreateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Creates a top-level category when empty.
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_blog_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_blog_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CreateCategoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MoveCategoryRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Makes the category top-level when empty.
+	NewParentId   string `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3" json:"new_parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_blog_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MoveCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetNewParentId() string {
+	if x != nil {
+		return x.NewParentId
+	}
+	return ""
+}
+
+type MoveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryResponse) Reset() {
+	*x = MoveCategoryResponse{}
+	mi := &file_blog_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryResponse) ProtoMessage() {}
+
+func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MoveCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *MoveCategoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_blog_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{63}
+}
+
+type ListCategoriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Depth first, with siblings ordered by name.
+	Categories    []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Error         string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_blog_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListCategoryPostsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Maximum number of posts to return; defaults to 20.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryPostsRequest) Reset() {
+	*x = ListCategoryPostsRequest{}
+	mi := &file_blog_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryPostsRequest) ProtoMessage() {}
+
+func (x *ListCategoryPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryPostsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListCategoryPostsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListCategoryPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCategoryPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCategoryPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Posts in the category and all of its descendants, newest first.
+	Posts         []*BlogPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Error         string      `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryPostsResponse) Reset() {
+	*x = ListCategoryPostsResponse{}
+	mi := &file_blog_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryPostsResponse) ProtoMessage() {}
+
+func (x *ListCategoryPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryPostsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListCategoryPostsResponse) GetPosts() []*BlogPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListCategoryPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCategoryPostsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Series struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId    string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Author      string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	// Member posts in reading order.
+	PostIds       []string `protobuf:"bytes,5,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	mi := &file_blog_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Series) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Series) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Series) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Series) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Series) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+// SeriesNavigation locates a post within its series.
+type SeriesNavigation struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// One-based position of the post in the series.
+	Position int32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Total    int32 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Empty for the first post.
+	PreviousPostId string `protobuf:"bytes,5,opt,name=previous_post_id,json=previousPostId,proto3" json:"previous_post_id,omitempty"`
+	// Empty for the last post.
+	NextPostId    string `protobuf:"bytes,6,opt,name=next_post_id,json=nextPostId,proto3" json:"next_post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesNavigation) Reset() {
+	*x = SeriesNavigation{}
+	mi := &file_blog_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesNavigation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesNavigation) ProtoMessage() {}
+
+func (x *SeriesNavigation) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesNavigation.ProtoReflect.Descriptor instead.
+func (*SeriesNavigation) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SeriesNavigation) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *SeriesNavigation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SeriesNavigation) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SeriesNavigation) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SeriesNavigation) GetPreviousPostId() string {
+	if x != nil {
+		return x.PreviousPostId
+	}
+	return ""
+}
+
+func (x *SeriesNavigation) GetNextPostId() string {
+	if x != nil {
+		return x.NextPostId
+	}
+	return ""
+}
+
+type CreateSeriesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Ignored for authenticated callers.
+	Author        string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeriesRequest) Reset() {
+	*x = CreateSeriesRequest{}
+	mi := &file_blog_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeriesRequest) ProtoMessage() {}
+
+func (x *CreateSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateSeriesRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateSeriesRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSeriesRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type CreateSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *Series                `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeriesResponse) Reset() {
+	*x = CreateSeriesResponse{}
+	mi := &file_blog_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeriesResponse) ProtoMessage() {}
+
+func (x *CreateSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CreateSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateSeriesResponse) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *CreateSeriesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddPostToSeriesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// A post belongs to at most one series. It is added at the end.
+	PostId        string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPostToSeriesRequest) Reset() {
+	*x = AddPostToSeriesRequest{}
+	mi := &file_blog_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPostToSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPostToSeriesRequest) ProtoMessage() {}
+
+func (x *AddPostToSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPostToSeriesRequest.ProtoReflect.Descriptor instead.
+func (*AddPostToSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AddPostToSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *AddPostToSeriesRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type AddPostToSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *Series                `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPostToSeriesResponse) Reset() {
+	*x = AddPostToSeriesResponse{}
+	mi := &file_blog_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPostToSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPostToSeriesResponse) ProtoMessage() {}
+
+func (x *AddPostToSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPostToSeriesResponse.ProtoReflect.Descriptor instead.
+func (*AddPostToSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AddPostToSeriesResponse) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *AddPostToSeriesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReorderSeriesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Every member post, in the new reading order.
+	PostIds       []string `protobuf:"bytes,2,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSeriesRequest) Reset() {
+	*x = ReorderSeriesRequest{}
+	mi := &file_blog_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSeriesRequest) ProtoMessage() {}
+
+func (x *ReorderSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReorderSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *ReorderSeriesRequest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type ReorderSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *Series                `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSeriesResponse) Reset() {
+	*x = ReorderSeriesResponse{}
+	mi := &file_blog_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSeriesResponse) ProtoMessage() {}
+
+func (x *ReorderSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReorderSeriesResponse) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *ReorderSeriesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SocialLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// For example "mastodon" or "github".
+	Platform      string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SocialLink) Reset() {
+	*x = SocialLink{}
+	mi := &file_blog_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SocialLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialLink) ProtoMessage() {}
+
+func (x *SocialLink) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialLink.ProtoReflect.Descriptor instead.
+func (*SocialLink) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SocialLink) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *SocialLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type Author struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authenticated authors use their identity's subject. Authors of posts
+	// written before profiles existed use the name recorded on those posts.
+	AuthorId      string        `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	DisplayName   string        `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio           string        `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string        `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	SocialLinks   []*SocialLink `protobuf:"bytes,5,rep,name=social_links,json=socialLinks,proto3" json:"social_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_blog_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Author) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Author) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Author) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Author) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Author) GetSocialLinks() []*SocialLink {
+	if x != nil {
+		return x.SocialLinks
+	}
+	return nil
+}
+
+type CreateAuthorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ignored for authenticated callers, who create their own profile.
+	AuthorId      string        `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	DisplayName   string        `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio           string        `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string        `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	SocialLinks   []*SocialLink `protobuf:"bytes,5,rep,name=social_links,json=socialLinks,proto3" json:"social_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthorRequest) Reset() {
+	*x = CreateAuthorRequest{}
+	mi := &file_blog_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorRequest) ProtoMessage() {}
+
+func (x *CreateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetSocialLinks() []*SocialLink {
+	if x != nil {
+		return x.SocialLinks
+	}
+	return nil
+}
+
+type CreateAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthorResponse) Reset() {
+	*x = CreateAuthorResponse{}
+	mi := &file_blog_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorResponse) ProtoMessage() {}
+
+func (x *CreateAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorResponse.ProtoReflect.Descriptor instead.
+func (*CreateAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *CreateAuthorResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorRequest) Reset() {
+	*x = GetAuthorRequest{}
+	mi := &file_blog_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorRequest) ProtoMessage() {}
+
+func (x *GetAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type GetAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorResponse) Reset() {
+	*x = GetAuthorResponse{}
+	mi := &file_blog_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorResponse) ProtoMessage() {}
+
+func (x *GetAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *GetAuthorResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	SocialLinks   []*SocialLink          `protobuf:"bytes,5,rep,name=social_links,json=socialLinks,proto3" json:"social_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuthorRequest) Reset() {
+	*x = UpdateAuthorRequest{}
+	mi := &file_blog_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthorRequest) ProtoMessage() {}
+
+func (x *UpdateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetSocialLinks() []*SocialLink {
+	if x != nil {
+		return x.SocialLinks
+	}
+	return nil
+}
+
+type UpdateAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuthorResponse) Reset() {
+	*x = UpdateAuthorResponse{}
+	mi := &file_blog_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthorResponse) ProtoMessage() {}
+
+func (x *UpdateAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *UpdateAuthorResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListAuthorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of authors to return; defaults to 20.
+	PageSize      int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsRequest) Reset() {
+	*x = ListAuthorsRequest{}
+	mi := &file_blog_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsRequest) ProtoMessage() {}
+
+func (x *ListAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListAuthorsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuthorsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuthorsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by display name.
+	Authors       []*Author `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Error         string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsResponse) Reset() {
+	*x = ListAuthorsResponse{}
+	mi := &file_blog_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsResponse) ProtoMessage() {}
+
+func (x *ListAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListAuthorsResponse) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *ListAuthorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAuthorsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FollowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exactly one of author_id and tag must be set.
+	AuthorId string `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Normalized like the tags of new posts.
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Who follows. Ignored for authenticated callers.
+	User          string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
+	mi := &file_blog_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequest) ProtoMessage() {}
+
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *FollowRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *FollowRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *FollowRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type FollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowResponse) Reset() {
+	*x = FollowResponse{}
+	mi := &file_blog_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowResponse) ProtoMessage() {}
+
+func (x *FollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
+func (*FollowResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FollowResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FollowResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UnfollowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exactly one of author_id and tag must be set.
+	AuthorId string `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Tag      string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Who follows. Ignored for authenticated callers.
+	User          string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowRequest) Reset() {
+	*x = UnfollowRequest{}
+	mi := &file_blog_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowRequest) ProtoMessage() {}
+
+func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UnfollowRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *UnfollowRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *UnfollowRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type UnfollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowResponse) Reset() {
+	*x = UnfollowResponse{}
+	mi := &file_blog_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowResponse) ProtoMessage() {}
+
+func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UnfollowResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnfollowResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListFollowingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whose subscriptions to list. Ignored for authenticated callers.
+	User          string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowingRequest) Reset() {
+	*x = ListFollowingRequest{}
+	mi := &file_blog_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingRequest) ProtoMessage() {}
+
+func (x *ListFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListFollowingRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ListFollowingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorIds     []string               `protobuf:"bytes,1,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowingResponse) Reset() {
+	*x = ListFollowingResponse{}
+	mi := &file_blog_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingResponse) ProtoMessage() {}
+
+func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListFollowingResponse) GetAuthorIds() []string {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+func (x *ListFollowingResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListFollowingResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetHomeFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whose feed to return. Ignored for authenticated callers.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Maximum number of posts to return; defaults to 20.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomeFeedRequest) Reset() {
+	*x = GetHomeFeedRequest{}
+	mi := &file_blog_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomeFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeFeedRequest) ProtoMessage() {}
+
+func (x *GetHomeFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetHomeFeedRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetHomeFeedRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *GetHomeFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetHomeFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetHomeFeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Posts by followed authors or with followed tags, newest first.
+	Posts         []*BlogPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Error         string      `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomeFeedResponse) Reset() {
+	*x = GetHomeFeedResponse{}
+	mi := &file_blog_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomeFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeFeedResponse) ProtoMessage() {}
+
+func (x *GetHomeFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetHomeFeedResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetHomeFeedResponse) GetPosts() []*BlogPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetHomeFeedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetHomeFeedResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Webhook struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Url       string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Any of post.created, post.updated, post.deleted and post.published.
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_blog_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Webhook) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	WebhookId  string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId    string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	PostId     string                 `protobuf:"bytes,5,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	State      DeliveryState          `protobuf:"varint,6,opt,name=state,proto3,enum=blog.DeliveryState" json:"state,omitempty"`
+	Attempts   int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// HTTP status of the last attempt, or 0 if no response was received.
+	LastStatusCode int32                  `protobuf:"varint,8,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_blog_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *WebhookDelivery) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetState() DeliveryState {
+	if x != nil {
+		return x.State
+	}
+	return DeliveryState_DELIVERY_STATE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Url        string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Key for the HMAC-SHA256 signature sent in the X-Blog-Signature header.
+	Secret        string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_blog_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_blog_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_blog_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{97}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_blog_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_blog_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_blog_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteWebhookResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_blog_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Recent deliveries, newest first.
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Error         string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_blog_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_blog_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{103}
+}
+
+type ListDeadLettersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deliveries that ran out of attempts, newest first.
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Error         string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_blog_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListDeadLettersResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_blog_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_blog_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *RedeliverWebhookResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_blog_proto protoreflect.FileDescriptor
+
+const file_blog_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"blog.proto\x12\x04blog\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0evalidate.proto\"\xb8\x03\n" +
+	"\bBlogPost\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12E\n" +
+	"\x10publication_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0fpublicationDate\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12K\n" +
+	"\x0freaction_counts\x18\a \x03(\v2\".blog.BlogPost.ReactionCountsEntryR\x0ereactionCounts\x12\x1f\n" +
+	"\vcategory_id\x18\b \x01(\tR\n" +
+	"categoryId\x12\x1b\n" +
+	"\tauthor_id\x18\t \x01(\tR\bauthorId\x12\"\n" +
+	"\rco_author_ids\x18\n" +
+	" \x03(\tR\vcoAuthorIds\x1aA\n" +
+	"\x13ReactionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xae\x03\n" +
+	"\x11CreatePostRequest\x12\x1f\n" +
+	"\x05title\x18\x01 \x01(\tB\t\x8a\xb5\x18\x05\b\x01\x10\xc8\x01R\x05title\x12$\n" +
+	"\acontent\x18\x02 \x01(\tB\n" +
+	"\x8a\xb5\x18\x06\b\x01\x18\x80\xa0\x06R\acontent\x12@\n" +
+	"\x06author\x18\x03 \x01(\tB(\x8a\xb5\x18$\x10d\" ^[\\p{L}\\p{N}][\\p{L}\\p{N} .'_-]*$R\x06author\x12M\n" +
+	"\x10publication_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\x8a\xb5\x18\x02\b\x01R\x0fpublicationDate\x12@\n" +
+	"\x04tags\x18\x05 \x03(\tB,\x8a\xb5\x18((\n" +
+	"02: ^[\\p{L}\\p{N}][\\p{L}\\p{N}._+#-]*$@\x01R\x04tags\x120\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tB\a\x8a\xb5\x18\x03\x10\xff\x01R\x0eidempotencyKey\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\tR\n" +
+	"categoryId\x12,\n" +
+	"\rco_author_ids\x18\b \x03(\tB\b\x8a\xb5\x18\x04(\n" +
+	"@\x01R\vcoAuthorIds\"\xaf\x01\n" +
+	"\x12CreatePostResponse\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.blog.BlogPostR\x04post\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1a\n" +
+	"\breplayed\x18\x03 \x01(\bR\breplayed\x12C\n" +
+	"\x12duplicate_warnings\x18\x04 \x03(\v2\x14.blog.DuplicateMatchR\x11duplicateWarnings\"I\n" +
+	"\x0eDuplicateMatch\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\"2\n" +
+	"\x0fReadPostRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\"|\n" +
+	"\x10ReadPostResponse\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.blog.BlogPostR\x04post\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12.\n" +
+	"\x06series\x18\x03 \x01(\v2\x16.blog.SeriesNavigationR\x06series\"\x8b\x03\n" +
+	"\x11UpdatePostRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\x12\x1f\n" +
+	"\x05title\x18\x02 \x01(\tB\t\x8a\xb5\x18\x05\b\x01\x10\xc8\x01R\x05title\x12$\n" +
+	"\acontent\x18\x03 \x01(\tB\n" +
+	"\x8a\xb5\x18\x06\b\x01\x18\x80\xa0\x06R\acontent\x12@\n" +
+	"\x06author\x18\x04 \x01(\tB(\x8a\xb5\x18$\x10d\" ^[\\p{L}\\p{N}][\\p{L}\\p{N} .'_-]*$R\x06author\x12@\n" +
+	"\x04tags\x18\x05 \x03(\tB,\x8a\xb5\x18((\n" +
+	"02: ^[\\p{L}\\p{N}][\\p{L}\\p{N}._+#-]*$@\x01R\x04tags\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\x12,\n" +
+	"\rco_author_ids\x18\a \x03(\tB\b\x8a\xb5\x18\x04(\n" +
+	"@\x01R\vcoAuthorIds\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"N\n" +
+	"\x12UpdatePostResponse\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.blog.BlogPostR\x04post\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"4\n" +
+	"\x11DeletePostRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\"D\n" +
+	"\x12DeletePostResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"w\n" +
+	"\x12ReactToPostRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\x12$\n" +
+	"\breaction\x18\x02 \x01(\tB\b\x8a\xb5\x18\x04\b\x01\x10 R\breaction\x12\x1a\n" +
+	"\x04user\x18\x03 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\x04user\"\xc6\x01\n" +
+	"\x13ReactToPostResponse\x12V\n" +
+	"\x0freaction_counts\x18\x01 \x03(\v2-.blog.ReactToPostResponse.ReactionCountsEntryR\x0ereactionCounts\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x1aA\n" +
+	"\x13ReactionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"z\n" +
+	"\x15RemoveReactionRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\x12$\n" +
+	"\breaction\x18\x02 \x01(\tB\b\x8a\xb5\x18\x04\b\x01\x10 R\breaction\x12\x1a\n" +
+	"\x04user\x18\x03 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\x04user\"\xcc\x01\n" +
+	"\x16RemoveReactionResponse\x12Y\n" +
+	"\x0freaction_counts\x18\x01 \x03(\v20.blog.RemoveReactionResponse.ReactionCountsEntryR\x0ereactionCounts\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x1aA\n" +
+	"\x13ReactionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"T\n" +
+	"\x11RecordViewRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\x12\x1e\n" +
+	"\x06viewer\x18\x02 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\x06viewer\"D\n" +
+	"\x12RecordViewResponse\x12\x18\n" +
+	"\acounted\x18\x01 \x01(\bR\acounted\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xf2\x01\n" +
+	"\x13GetPostStatsRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\x12A\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\x8a\xb5\x18\x02\b\x01R\tstartTime\x12=\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\x8a\xb5\x18\x02\b\x01R\aendTime\x128\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x16.blog.StatsGranularityR\vgranularity\"]\n" +
+	"\n" +
+	"ViewBucket\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\"y\n" +
+	"\x14GetPostStatsResponse\x12*\n" +
+	"\abuckets\x18\x01 \x03(\v2\x10.blog.ViewBucketR\abuckets\x12\x1f\n" +
+	"\vtotal_views\x18\x02 \x01(\x03R\n" +
+	"totalViews\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"J\n" +
+	"\x18ListTrendingPostsRequest\x12\x18\n" +
+	"\x03tag\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\x102R\x03tag\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"H\n" +
+	"\fTrendingPost\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.blog.BlogPostR\x04post\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"[\n" +
+	"\x19ListTrendingPostsResponse\x12(\n" +
+	"\x05posts\x18\x01 \x03(\v2\x12.blog.TrendingPostR\x05posts\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"O\n" +
+	"\x16GetRelatedPostsRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"G\n" +
+	"\vRelatedPost\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.blog.BlogPostR\x04post\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"X\n" +
+	"\x17GetRelatedPostsResponse\x12'\n" +
+	"\x05posts\x18\x01 \x03(\v2\x11.blog.RelatedPostR\x05posts\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xfa\x01\n" +
+	"\x06ApiKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\arevoked\x18\a \x01(\bR\arevoked\"\x94\x01\n" +
+	"\x13CreateApiKeyRequest\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x8a\xb5\x18\x04\b\x01\x10dR\x04name\x12$\n" +
+	"\x06scopes\x18\x02 \x03(\tB\f\x8a\xb5\x18\b\b\x01(\x140d@\x01R\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"e\n" +
+	"\x14CreateApiKeyResponse\x12%\n" +
+	"\aapi_key\x18\x01 \x01(\v2\f.blog.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x14\n" +
+	"\x12ListApiKeysRequest\"T\n" +
+	"\x13ListApiKeysResponse\x12'\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\f.blog.ApiKeyR\aapiKeys\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"4\n" +
+	"\x13RevokeApiKeyRequest\x12\x1d\n" +
+	"\x06key_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x05keyId\"F\n" +
+	"\x14RevokeApiKeyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xdb\x03\n" +
+	"\aComment\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\adeleted\x18\b \x01(\bR\adeleted\x12'\n" +
+	"\areplies\x18\t \x03(\v2\r.blog.CommentR\areplies\x12@\n" +
+	"\x10moderation_state\x18\n" +
+	" \x01(\x0e2\x15.blog.ModerationStateR\x0fmoderationState\x12+\n" +
+	"\x11moderation_reason\x18\v \x01(\tR\x10moderationReason\x12!\n" +
+	"\fmoderated_by\x18\f \x01(\tR\vmoderatedBy\"\xb8\x01\n" +
+	"\x11AddCommentRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12@\n" +
+	"\x06author\x18\x03 \x01(\tB(\x8a\xb5\x18$\x10d\" ^[\\p{L}\\p{N}][\\p{L}\\p{N} .'_-]*$R\x06author\x12#\n" +
+	"\acontent\x18\x04 \x01(\tB\t\x8a\xb5\x18\x05\b\x01\x18\x80PR\acontent\"S\n" +
+	"\x12AddCommentResponse\x12'\n" +
+	"\acomment\x18\x01 \x01(\v2\r.blog.CommentR\acomment\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"r\n" +
+	"\x13ListCommentsRequest\x12\x1f\n" +
+	"\apost_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x14ListCommentsResponse\x12)\n" +
+	"\bcomments\x18\x01 \x03(\v2\r.blog.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"`\n" +
+	"\x12EditCommentRequest\x12%\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\tcommentId\x12#\n" +
+	"\acontent\x18\x02 \x01(\tB\t\x8a\xb5\x18\x05\b\x01\x18\x80PR\acontent\"T\n" +
+	"\x13EditCommentResponse\x12'\n" +
+	"\acomment\x18\x01 \x01(\v2\r.blog.CommentR\acomment\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"=\n" +
+	"\x14DeleteCommentRequest\x12%\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\tcommentId\"G\n" +
+	"\x15DeleteCommentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x85\x01\n" +
+	"\x1aListModerationQueueRequest\x12+\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x15.blog.ModerationStateR\x05state\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x86\x01\n" +
+	"\x1bListModerationQueueResponse\x12)\n" +
+	"\bcomments\x18\x01 \x03(\v2\r.blog.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x8d\x01\n" +
+	"\x16ModerateCommentRequest\x12%\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\tcommentId\x12+\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x15.blog.ModerationStateR\x05state\x12\x1f\n" +
+	"\x06reason\x18\x03 \x01(\tB\a\x8a\xb5\x18\x03\x10\xf4\x03R\x06reason\"X\n" +
+	"\x17ModerateCommentResponse\x12'\n" +
+	"\acomment\x18\x01 \x01(\v2\r.blog.CommentR\acomment\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"M\n" +
+	"\x15FindDuplicatesRequest\x124\n" +
+	"\tthreshold\x18\x01 \x01(\x01B\x16\x8a\xb5\x18\x12I\x00\x00\x00\x00\x00\x00\x00\x00Q\x00\x00\x00\x00\x00\x00\xf0?R\tthreshold\"t\n" +
+	"\rDuplicatePair\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12*\n" +
+	"\x11duplicate_post_id\x18\x02 \x01(\tR\x0fduplicatePostId\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x01R\n" +
+	"similarity\"Y\n" +
+	"\x16FindDuplicatesResponse\x12)\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x13.blog.DuplicatePairR\x05pairs\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\";\n" +
+	"\bTagCount\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05R\tpostCount\"\x11\n" +
+	"\x0fListTagsRequest\"L\n" +
+	"\x10ListTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.blog.TagCountR\x04tags\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"z\n" +
+	"\x10RenameTagRequest\x12!\n" +
+	"\aold_tag\x18\x01 \x01(\tB\b\x8a\xb5\x18\x04\b\x01\x102R\x06oldTag\x12C\n" +
+	"\anew_tag\x18\x02 \x01(\tB*\x8a\xb5\x18&\b\x01\x102\" ^[\\p{L}\\p{N}][\\p{L}\\p{N}._+#-]*$R\x06newTag\"N\n" +
+	"\x11RenameTagResponse\x12#\n" +
+	"\rposts_updated\x18\x01 \x01(\x05R\fpostsUpdated\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x8a\x01\n" +
+	"\x10MergeTagsRequest\x12+\n" +
+	"\vsource_tags\x18\x01 \x03(\tB\n" +
+	"\x8a\xb5\x18\x06(202@\x01R\n" +
+	"sourceTags\x12I\n" +
+	"\n" +
+	"target_tag\x18\x02 \x01(\tB*\x8a\xb5\x18&\b\x01\x102\" ^[\\p{L}\\p{N}][\\p{L}\\p{N}._+#-]*$R\ttargetTag\"N\n" +
+	"\x11MergeTagsResponse\x12#\n" +
+	"\rposts_updated\x18\x01 \x01(\x05R\fpostsUpdated\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"H\n" +
+	"\x12SuggestTagsRequest\x12\x1c\n" +
+	"\x05query\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\x102R\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\\\n" +
+	"\rTagSuggestion\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05R\tpostCount\x12\x1a\n" +
+	"\bdistance\x18\x03 \x01(\x05R\bdistance\"b\n" +
+	"\x13SuggestTagsResponse\x125\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x13.blog.TagSuggestionR\vsuggestions\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x8f\x01\n" +
+	"\bCategory\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04path\x18\x04 \x03(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x05 \x01(\x05R\tpostCount\"R\n" +
+	"\x15CreateCategoryRequest\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x8a\xb5\x18\x04\b\x01\x10dR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"Z\n" +
+	"\x16CreateCategoryResponse\x12*\n" +
+	"\bcategory\x18\x01 \x01(\v2\x0e.blog.CategoryR\bcategory\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"b\n" +
+	"\x13MoveCategoryRequest\x12'\n" +
+	"\vcategory_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\n" +
+	"categoryId\x12\"\n" +
+	"\rnew_parent_id\x18\x02 \x01(\tR\vnewParentId\"X\n" +
+	"\x14MoveCategoryResponse\x12*\n" +
+	"\bcategory\x18\x01 \x01(\v2\x0e.blog.CategoryR\bcategory\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x17\n" +
+	"\x15ListCategoriesRequest\"^\n" +
+	"\x16ListCategoriesResponse\x12.\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x0e.blog.CategoryR\n" +
+	"categories\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x7f\n" +
+	"\x18ListCategoryPostsRequest\x12'\n" +
+	"\vcategory_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\n" +
+	"categoryId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x19ListCategoryPostsResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.blog.BlogPostR\x05posts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x90\x01\n" +
+	"\x06Series\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x19\n" +
+	"\bpost_ids\x18\x05 \x03(\tR\apostIds\"\xc3\x01\n" +
+	"\x10SeriesNavigation\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12(\n" +
+	"\x10previous_post_id\x18\x05 \x01(\tR\x0epreviousPostId\x12 \n" +
+	"\fnext_post_id\x18\x06 \x01(\tR\n" +
+	"nextPostId\"\xa3\x01\n" +
+	"\x13CreateSeriesRequest\x12\x1f\n" +
+	"\x05title\x18\x01 \x01(\tB\t\x8a\xb5\x18\x05\b\x01\x10\xc8\x01R\x05title\x12)\n" +
+	"\vdescription\x18\x02 \x01(\tB\a\x8a\xb5\x18\x03\x10\xd0\x0fR\vdescription\x12@\n" +
+	"\x06author\x18\x03 \x01(\tB(\x8a\xb5\x18$\x10d\" ^[\\p{L}\\p{N}][\\p{L}\\p{N} .'_-]*$R\x06author\"R\n" +
+	"\x14CreateSeriesResponse\x12$\n" +
+	"\x06series\x18\x01 \x01(\v2\f.blog.SeriesR\x06series\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"^\n" +
+	"\x16AddPostToSeriesRequest\x12#\n" +
+	"\tseries_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\bseriesId\x12\x1f\n" +
+	"\apost_id\x18\x02 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\x06postId\"U\n" +
+	"\x17AddPostToSeriesResponse\x12$\n" +
+	"\x06series\x18\x01 \x01(\v2\f.blog.SeriesR\x06series\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"^\n" +
+	"\x14ReorderSeriesRequest\x12#\n" +
+	"\tseries_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\bseriesId\x12!\n" +
+	"\bpost_ids\x18\x02 \x03(\tB\x06\x8a\xb5\x18\x02@\x01R\apostIds\"S\n" +
+	"\x15ReorderSeriesResponse\x12$\n" +
+	"\x06series\x18\x01 \x01(\v2\f.blog.SeriesR\x06series\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"[\n" +
+	"\n" +
+	"SocialLink\x12$\n" +
+	"\bplatform\x18\x01 \x01(\tB\b\x8a\xb5\x18\x04\b\x01\x102R\bplatform\x12'\n" +
+	"\x03url\x18\x02 \x01(\tB\x15\x8a\xb5\x18\x11\b\x01\x10\x80\x10\"\n" +
+	"^https?://R\x03url\"\xae\x01\n" +
+	"\x06Author\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x123\n" +
+	"\fsocial_links\x18\x05 \x03(\v2\x10.blog.SocialLinkR\vsocialLinks\"\x95\x02\n" +
+	"\x13CreateAuthorRequest\x12E\n" +
+	"\tauthor_id\x18\x01 \x01(\tB(\x8a\xb5\x18$\x10d\" ^[\\p{L}\\p{N}][\\p{L}\\p{N} .'_-]*$R\bauthorId\x12+\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\b\x8a\xb5\x18\x04\b\x01\x10dR\vdisplayName\x12\x19\n" +
+	"\x03bio\x18\x03 \x01(\tB\a\x8a\xb5\x18\x03\x10\xd0\x0fR\x03bio\x122\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tB\x13\x8a\xb5\x18\x0f\x10\x80\x10\"\n" +
+	"^https?://R\tavatarUrl\x12;\n" +
+	"\fsocial_links\x18\x05 \x03(\v2\x10.blog.SocialLinkB\x06\x8a\xb5\x18\x02(\n" +
+	"R\vsocialLinks\"R\n" +
+	"\x14CreateAuthorResponse\x12$\n" +
+	"\x06author\x18\x01 \x01(\v2\f.blog.AuthorR\x06author\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"7\n" +
+	"\x10GetAuthorRequest\x12#\n" +
+	"\tauthor_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\bauthorId\"O\n" +
+	"\x11GetAuthorResponse\x12$\n" +
+	"\x06author\x18\x01 \x01(\v2\f.blog.AuthorR\x06author\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xf3\x01\n" +
+	"\x13UpdateAuthorRequest\x12#\n" +
+	"\tauthor_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\bauthorId\x12+\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\b\x8a\xb5\x18\x04\b\x01\x10dR\vdisplayName\x12\x19\n" +
+	"\x03bio\x18\x03 \x01(\tB\a\x8a\xb5\x18\x03\x10\xd0\x0fR\x03bio\x122\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tB\x13\x8a\xb5\x18\x0f\x10\x80\x10\"\n" +
+	"^https?://R\tavatarUrl\x12;\n" +
+	"\fsocial_links\x18\x05 \x03(\v2\x10.blog.SocialLinkB\x06\x8a\xb5\x18\x02(\n" +
+	"R\vsocialLinks\"R\n" +
+	"\x14UpdateAuthorResponse\x12$\n" +
+	"\x06author\x18\x01 \x01(\v2\f.blog.AuthorR\x06author\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"P\n" +
+	"\x12ListAuthorsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"{\n" +
+	"\x13ListAuthorsResponse\x12&\n" +
+	"\aauthors\x18\x01 \x03(\v2\f.blog.AuthorR\aauthors\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x8c\x01\n" +
+	"\rFollowRequest\x12#\n" +
+	"\tauthor_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\bauthorId\x12:\n" +
+	"\x03tag\x18\x02 \x01(\tB(\x8a\xb5\x18$\x102\" ^[\\p{L}\\p{N}][\\p{L}\\p{N}._+#-]*$R\x03tag\x12\x1a\n" +
+	"\x04user\x18\x03 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\x04user\"@\n" +
+	"\x0eFollowResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"l\n" +
+	"\x0fUnfollowRequest\x12#\n" +
+	"\tauthor_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\bauthorId\x12\x18\n" +
+	"\x03tag\x18\x02 \x01(\tB\x06\x8a\xb5\x18\x02\x102R\x03tag\x12\x1a\n" +
+	"\x04user\x18\x03 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\x04user\"B\n" +
+	"\x10UnfollowResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"2\n" +
+	"\x14ListFollowingRequest\x12\x1a\n" +
+	"\x04user\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\x04user\"`\n" +
+	"\x15ListFollowingResponse\x12\x1d\n" +
+	"\n" +
+	"author_ids\x18\x01 \x03(\tR\tauthorIds\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"l\n" +
+	"\x12GetHomeFeedRequest\x12\x1a\n" +
+	"\x04user\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\x10dR\x04user\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"y\n" +
+	"\x13GetHomeFeedResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.blog.BlogPostR\x05posts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x96\x01\n" +
+	"\aWebhook\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf7\x03\n" +
+	"\x0fWebhookDelivery\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x17\n" +
+	"\apost_id\x18\x05 \x01(\tR\x06postId\x12)\n" +
+	"\x05state\x18\x06 \x01(\x0e2\x13.blog.DeliveryStateR\x05state\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\b \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\x0flast_attempt_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rlastAttemptAt\x12B\n" +
+	"\x0fnext_attempt_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\"\x83\x01\n" +
+	"\x14CreateWebhookRequest\x12\x1b\n" +
+	"\x03url\x18\x01 \x01(\tB\t\x8a\xb5\x18\x05\b\x01\x10\xd0\x0fR\x03url\x12+\n" +
+	"\vevent_types\x18\x02 \x03(\tB\n" +
+	"\x8a\xb5\x18\x06\b\x01(\x04@\x01R\n" +
+	"eventTypes\x12!\n" +
+	"\x06secret\x18\x03 \x01(\tB\t\x8a\xb5\x18\x05\b\x01\x10\xc8\x01R\x06secret\"V\n" +
+	"\x15CreateWebhookResponse\x12'\n" +
+	"\awebhook\x18\x01 \x01(\v2\r.blog.WebhookR\awebhook\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x15\n" +
+	"\x13ListWebhooksRequest\"W\n" +
+	"\x14ListWebhooksResponse\x12)\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\r.blog.WebhookR\bwebhooks\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"=\n" +
+	"\x14DeleteWebhookRequest\x12%\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\twebhookId\"G\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"E\n" +
+	"\x1cListWebhookDeliveriesRequest\x12%\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\twebhookId\"l\n" +
+	"\x1dListWebhookDeliveriesResponse\x125\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x15.blog.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x18\n" +
+	"\x16ListDeadLettersRequest\"f\n" +
+	"\x17ListDeadLettersResponse\x125\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x15.blog.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"B\n" +
+	"\x17RedeliverWebhookRequest\x12'\n" +
+	"\vdelivery_id\x18\x01 \x01(\tB\x06\x8a\xb5\x18\x02\b\x01R\n" +
+	"deliveryId\"c\n" +
+	"\x18RedeliverWebhookResponse\x121\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x15.blog.WebhookDeliveryR\bdelivery\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error*p\n" +
+	"\x10StatsGranularity\x12!\n" +
+	"\x1dSTATS_GRANULARITY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18STATS_GRANULARITY_HOURLY\x10\x01\x12\x1b\n" +
+	"\x17STATS_GRANULARITY_DAILY\x10\x02*\xaa\x01\n" +
+	"\x0fModerationState\x12 \n" +
+	"\x1cMODERATION_STATE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MODERATION_STATE_PENDING\x10\x01\x12\x1d\n" +
+	"\x19MODERATION_STATE_APPROVED\x10\x02\x12\x1d\n" +
+	"\x19MODERATION_STATE_REJECTED\x10\x03\x12\x19\n" +
+	"\x15MODERATION_STATE_SPAM\x10\x04*\x82\x01\n" +
+	"\rDeliveryState\x12\x1e\n" +
+	"\x1aDELIVERY_STATE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16DELIVERY_STATE_PENDING\x10\x01\x12\x1c\n" +
+	"\x18DELIVERY_STATE_SUCCEEDED\x10\x02\x12\x17\n" +
+	"\x13DELIVERY_STATE_DEAD\x10\x032\xca\x05\n" +
+	"\vBlogService\x12?\n" +
+	"\n" +
+	"CreatePost\x12\x17.blog.CreatePostRequest\x1a\x18.blog.CreatePostResponse\x129\n" +
+	"\bReadPost\x12\x15.blog.ReadPostRequest\x1a\x16.blog.ReadPostResponse\x12?\n" +
+	"\n" +
+	"UpdatePost\x12\x17.blog.UpdatePostRequest\x1a\x18.blog.UpdatePostResponse\x12?\n" +
+	"\n" +
+	"DeletePost\x12\x17.blog.DeletePostRequest\x1a\x18.blog.DeletePostResponse\x12B\n" +
+	"\vReactToPost\x12\x18.blog.ReactToPostRequest\x1a\x19.blog.ReactToPostResponse\x12K\n" +
+	"\x0eRemoveReaction\x12\x1b.blog.RemoveReactionRequest\x1a\x1c.blog.RemoveReactionResponse\x12?\n" +
+	"\n" +
+	"RecordView\x12\x17.blog.RecordViewRequest\x1a\x18.blog.RecordViewResponse\x12E\n" +
+	"\fGetPostStats\x12\x19.blog.GetPostStatsRequest\x1a\x1a.blog.GetPostStatsResponse\x12T\n" +
+	"\x11ListTrendingPosts\x12\x1e.blog.ListTrendingPostsRequest\x1a\x1f.blog.ListTrendingPostsResponse\x12N\n" +
+	"\x0fGetRelatedPosts\x12\x1c.blog.GetRelatedPostsRequest\x1a\x1d.blog.GetRelatedPostsResponse2\xd2\x03\n" +
+	"\x0eCommentService\x12?\n" +
+	"\n" +
+	"AddComment\x12\x17.blog.AddCommentRequest\x1a\x18.blog.AddCommentResponse\x12E\n" +
+	"\fListComments\x12\x19.blog.ListCommentsRequest\x1a\x1a.blog.ListCommentsResponse\x12B\n" +
+	"\vEditComment\x12\x18.blog.EditCommentRequest\x1a\x19.blog.EditCommentResponse\x12H\n" +
+	"\rDeleteComment\x12\x1a.blog.DeleteCommentRequest\x1a\x1b.blog.DeleteCommentResponse\x12Z\n" +
+	"\x13ListModerationQueue\x12 .blog.ListModerationQueueRequest\x1a!.blog.ListModerationQueueResponse\x12N\n" +
+	"\x0fModerateComment\x12\x1c.blog.ModerateCommentRequest\x1a\x1d.blog.ModerateCommentResponse2\x87\x02\n" +
 	"\n" +
-	"DeletePost\x12\x17.blog.DeletePostRequest\x1a\x18.blog.DeletePostResponseB\tZ\a./;blogb\x06proto3"
+	"TagService\x129\n" +
+	"\bListTags\x12\x15.blog.ListTagsRequest\x1a\x16.blog.ListTagsResponse\x12<\n" +
+	"\tRenameTag\x12\x16.blog.RenameTagRequest\x1a\x17.blog.RenameTagResponse\x12<\n" +
+	"\tMergeTags\x12\x16.blog.MergeTagsRequest\x1a\x17.blog.MergeTagsResponse\x12B\n" +
+	"\vSuggestTags\x12\x18.blog.SuggestTagsRequest\x1a\x19.blog.SuggestTagsResponse2\xc8\x02\n" +
+	"\x0fCategoryService\x12K\n" +
+	"\x0eCreateCategory\x12\x1b.blog.CreateCategoryRequest\x1a\x1c.blog.CreateCategoryResponse\x12E\n" +
+	"\fMoveCategory\x12\x19.blog.MoveCategoryRequest\x1a\x1a.blog.MoveCategoryResponse\x12K\n" +
+	"\x0eListCategories\x12\x1b.blog.ListCategoriesRequest\x1a\x1c.blog.ListCategoriesResponse\x12T\n" +
+	"\x11ListCategoryPosts\x12\x1e.blog.ListCategoryPostsRequest\x1a\x1f.blog.ListCategoryPostsResponse2\xf0\x01\n" +
+	"\rSeriesService\x12E\n" +
+	"\fCreateSeries\x12\x19.blog.CreateSeriesRequest\x1a\x1a.blog.CreateSeriesResponse\x12N\n" +
+	"\x0fAddPostToSeries\x12\x1c.blog.AddPostToSeriesRequest\x1a\x1d.blog.AddPostToSeriesResponse\x12H\n" +
+	"\rReorderSeries\x12\x1a.blog.ReorderSeriesRequest\x1a\x1b.blog.ReorderSeriesResponse2\x9f\x02\n" +
+	"\rAuthorService\x12E\n" +
+	"\fCreateAuthor\x12\x19.blog.CreateAuthorRequest\x1a\x1a.blog.CreateAuthorResponse\x12<\n" +
+	"\tGetAuthor\x12\x16.blog.GetAuthorRequest\x1a\x17.blog.GetAuthorResponse\x12E\n" +
+	"\fUpdateAuthor\x12\x19.blog.UpdateAuthorRequest\x1a\x1a.blog.UpdateAuthorResponse\x12B\n" +
+	"\vListAuthors\x12\x18.blog.ListAuthorsRequest\x1a\x19.blog.ListAuthorsResponse2\x8b\x02\n" +
+	"\vFeedService\x123\n" +
+	"\x06Follow\x12\x13.blog.FollowRequest\x1a\x14.blog.FollowResponse\x129\n" +
+	"\bUnfollow\x12\x15.blog.UnfollowRequest\x1a\x16.blog.UnfollowResponse\x12H\n" +
+	"\rListFollowing\x12\x1a.blog.ListFollowingRequest\x1a\x1b.blog.ListFollowingResponse\x12B\n" +
+	"\vGetHomeFeed\x12\x18.blog.GetHomeFeedRequest\x1a\x19.blog.GetHomeFeedResponse2\xf0\x03\n" +
+	"\x0eWebhookService\x12H\n" +
+	"\rCreateWebhook\x12\x1a.blog.CreateWebhookRequest\x1a\x1b.blog.CreateWebhookResponse\x12E\n" +
+	"\fListWebhooks\x12\x19.blog.ListWebhooksRequest\x1a\x1a.blog.ListWebhooksResponse\x12H\n" +
+	"\rDeleteWebhook\x12\x1a.blog.DeleteWebhookRequest\x1a\x1b.blog.DeleteWebhookResponse\x12`\n" +
+	"\x15ListWebhookDeliveries\x12\".blog.ListWebhookDeliveriesRequest\x1a#.blog.ListWebhookDeliveriesResponse\x12N\n" +
+	"\x0fListDeadLetters\x12\x1c.blog.ListDeadLettersRequest\x1a\x1d.blog.ListDeadLettersResponse\x12Q\n" +
+	"\x10RedeliverWebhook\x12\x1d.blog.RedeliverWebhookRequest\x1a\x1e.blog.RedeliverWebhookResponse2\xad\x02\n" +
+	"\fAdminService\x12E\n" +
+	"\fCreateApiKey\x12\x19.blog.CreateApiKeyRequest\x1a\x1a.blog.CreateApiKeyResponse\x12B\n" +
+	"\vListApiKeys\x12\x18.blog.ListApiKeysRequest\x1a\x19.blog.ListApiKeysResponse\x12E\n" +
+	"\fRevokeApiKey\x12\x19.blog.RevokeApiKeyRequest\x1a\x1a.blog.RevokeApiKeyResponse\x12K\n" +
+	"\x0eFindDuplicates\x12\x1b.blog.FindDuplicatesRequest\x1a\x1c.blog.FindDuplicatesResponseB\tZ\a./;blogb\x06proto3"
 
 var (
 	file_blog_proto_rawDescOnce sync.Once
@@ -616,38 +7017,285 @@ func file_blog_proto_rawDescGZIP() []byte {
 	return file_blog_proto_rawDescData
 }
 
-var file_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_blog_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 110)
 var file_blog_proto_goTypes = []any{
-	(*BlogPost)(nil),              // 0: blog.BlogPost
-	(*CreatePostRequest)(nil),     // 1: blog.CreatePostRequest
-	(*CreatePostResponse)(nil),    // 2: blog.CreatePostResponse
-	(*ReadPostRequest)(nil),       // 3: blog.ReadPostRequest
-	(*ReadPostResponse)(nil),      // 4: blog.ReadPostResponse
-	(*UpdatePostRequest)(nil),     // 5: blog.UpdatePostRequest
-	(*UpdatePostResponse)(nil),    // 6: blog.UpdatePostResponse
-	(*DeletePostRequest)(nil),     // 7: blog.DeletePostRequest
-	(*DeletePostResponse)(nil),    // 8: blog.DeletePostResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(StatsGranularity)(0),                 // 0: blog.StatsGranularity
+	(ModerationState)(0),                  // 1: blog.ModerationState
+	(DeliveryState)(0),                    // 2: blog.DeliveryState
+	(*BlogPost)(nil),                      // 3: blog.BlogPost
+	(*CreatePostRequest)(nil),             // 4: blog.CreatePostRequest
+	(*CreatePostResponse)(nil),            // 5: blog.CreatePostResponse
+	(*DuplicateMatch)(nil),                // 6: blog.DuplicateMatch
+	(*ReadPostRequest)(nil),               // 7: blog.ReadPostRequest
+	(*ReadPostResponse)(nil),              // 8: blog.ReadPostResponse
+	(*UpdatePostRequest)(nil),             // 9: blog.UpdatePostRequest
+	(*UpdatePostResponse)(nil),            // 10: blog.UpdatePostResponse
+	(*DeletePostRequest)(nil),             // 11: blog.DeletePostRequest
+	(*DeletePostResponse)(nil),            // 12: blog.DeletePostResponse
+	(*ReactToPostRequest)(nil),            // 13: blog.ReactToPostRequest
+	(*ReactToPostResponse)(nil),           // 14: blog.ReactToPostResponse
+	(*RemoveReactionRequest)(nil),         // 15: blog.RemoveReactionRequest
+	(*RemoveReactionResponse)(nil),        // 16: blog.RemoveReactionResponse
+	(*RecordViewRequest)(nil),             // 17: blog.RecordViewRequest
+	(*RecordViewResponse)(nil),            // 18: blog.RecordViewResponse
+	(*GetPostStatsRequest)(nil),           // 19: blog.GetPostStatsRequest
+	(*ViewBucket)(nil),                    // 20: blog.ViewBucket
+	(*GetPostStatsResponse)(nil),          // 21: blog.GetPostStatsResponse
+	(*ListTrendingPostsRequest)(nil),      // 22: blog.ListTrendingPostsRequest
+	(*TrendingPost)(nil),                  // 23: blog.TrendingPost
+	(*ListTrendingPostsResponse)(nil),     // 24: blog.ListTrendingPostsResponse
+	(*GetRelatedPostsRequest)(nil),        // 25: blog.GetRelatedPostsRequest
+	(*RelatedPost)(nil),                   // 26: blog.RelatedPost
+	(*GetRelatedPostsResponse)(nil),       // 27: blog.GetRelatedPostsResponse
+	(*ApiKey)(nil),                        // 28: blog.ApiKey
+	(*CreateApiKeyRequest)(nil),           // 29: blog.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),          // 30: blog.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),            // 31: blog.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),           // 32: blog.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),           // 33: blog.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),          // 34: blog.RevokeApiKeyResponse
+	(*Comment)(nil),                       // 35: blog.Comment
+	(*AddCommentRequest)(nil),             // 36: blog.AddCommentRequest
+	(*AddCommentResponse)(nil),            // 37: blog.AddCommentResponse
+	(*ListCommentsRequest)(nil),           // 38: blog.ListCommentsRequest
+	(*ListCommentsResponse)(nil),          // 39: blog.ListCommentsResponse
+	(*EditCommentRequest)(nil),            // 40: blog.EditCommentRequest
+	(*EditCommentResponse)(nil),           // 41: blog.EditCommentResponse
+	(*DeleteCommentRequest)(nil),          // 42: blog.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),         // 43: blog.DeleteCommentResponse
+	(*ListModerationQueueRequest)(nil),    // 44: blog.ListModerationQueueRequest
+	(*ListModerationQueueResponse)(nil),   // 45: blog.ListModerationQueueResponse
+	(*ModerateCommentRequest)(nil),        // 46: blog.ModerateCommentRequest
+	(*ModerateCommentResponse)(nil),       // 47: blog.ModerateCommentResponse
+	(*FindDuplicatesRequest)(nil),         // 48: blog.FindDuplicatesRequest
+	(*DuplicatePair)(nil),                 // 49: blog.DuplicatePair
+	(*FindDuplicatesResponse)(nil),        // 50: blog.FindDuplicatesResponse
+	(*TagCount)(nil),                      // 51: blog.TagCount
+	(*ListTagsRequest)(nil),               // 52: blog.ListTagsRequest
+	(*ListTagsResponse)(nil),              // 53: blog.ListTagsResponse
+	(*RenameTagRequest)(nil),              // 54: blog.RenameTagRequest
+	(*RenameTagResponse)(nil),             // 55: blog.RenameTagResponse
+	(*MergeTagsRequest)(nil),              // 56: blog.MergeTagsRequest
+	(*MergeTagsResponse)(nil),             // 57: blog.MergeTagsResponse
+	(*SuggestTagsRequest)(nil),            // 58: blog.SuggestTagsRequest
+	(*TagSuggestion)(nil),                 // 59: blog.TagSuggestion
+	(*SuggestTagsResponse)(nil),           // 60: blog.SuggestTagsResponse
+	(*Category)(nil),                      // 61: blog.Category
+	(*CreateCategoryRequest)(nil),         // 62: blog.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),        // 63: blog.CreateCategoryResponse
+	(*MoveCategoryRequest)(nil),           // 64: blog.MoveCategoryRequest
+	(*MoveCategoryResponse)(nil),          // 65: blog.MoveCategoryResponse
+	(*ListCategoriesRequest)(nil),         // 66: blog.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),        // 67: blog.ListCategoriesResponse
+	(*ListCategoryPostsRequest)(nil),      // 68: blog.ListCategoryPostsRequest
+	(*ListCategoryPostsResponse)(nil),     // 69: blog.ListCategoryPostsResponse
+	(*Series)(nil),                        // 70: blog.Series
+	(*SeriesNavigation)(nil),              // 71: blog.SeriesNavigation
+	(*CreateSeriesRequest)(nil),           // 72: blog.CreateSeriesRequest
+	(*CreateSeriesResponse)(nil),          // 73: blog.CreateSeriesResponse
+	(*AddPostToSeriesRequest)(nil),        // 74: blog.AddPostToSeriesRequest
+	(*AddPostToSeriesResponse)(nil),       // 75: blog.AddPostToSeriesResponse
+	(*ReorderSeriesRequest)(nil),          // 76: blog.ReorderSeriesRequest
+	(*ReorderSeriesResponse)(nil),         // 77: blog.ReorderSeriesResponse
+	(*SocialLink)(nil),                    // 78: blog.SocialLink
+	(*Author)(nil),                        // 79: blog.Author
+	(*CreateAuthorRequest)(nil),           // 80: blog.CreateAuthorRequest
+	(*CreateAuthorResponse)(nil),          // 81: blog.CreateAuthorResponse
+	(*GetAuthorRequest)(nil),              // 82: blog.GetAuthorRequest
+	(*GetAuthorResponse)(nil),             // 83: blog.GetAuthorResponse
+	(*UpdateAuthorRequest)(nil),           // 84: blog.UpdateAuthorRequest
+	(*UpdateAuthorResponse)(nil),          // 85: blog.UpdateAuthorResponse
+	(*ListAuthorsRequest)(nil),            // 86: blog.ListAuthorsRequest
+	(*ListAuthorsResponse)(nil),           // 87: blog.ListAuthorsResponse
+	(*FollowRequest)(nil),                 // 88: blog.FollowRequest
+	(*FollowResponse)(nil),                // 89: blog.FollowResponse
+	(*UnfollowRequest)(nil),               // 90: blog.UnfollowRequest
+	(*UnfollowResponse)(nil),              // 91: blog.UnfollowResponse
+	(*ListFollowingRequest)(nil),          // 92: blog.ListFollowingRequest
+	(*ListFollowingResponse)(nil),         // 93: blog.ListFollowingResponse
+	(*GetHomeFeedRequest)(nil),            // 94: blog.GetHomeFeedRequest
+	(*GetHomeFeedResponse)(nil),           // 95: blog.GetHomeFeedResponse
+	(*Webhook)(nil),                       // 96: blog.Webhook
+	(*WebhookDelivery)(nil),               // 97: blog.WebhookDelivery
+	(*CreateWebhookRequest)(nil),          // 98: blog.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),         // 99: blog.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),           // 100: blog.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),          // 101: blog.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),          // 102: blog.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),         // 103: blog.DeleteWebhookResponse
+	(*ListWebhookDeliveriesRequest)(nil),  // 104: blog.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil), // 105: blog.ListWebhookDeliveriesResponse
+	(*ListDeadLettersRequest)(nil),        // 106: blog.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),       // 107: blog.ListDeadLettersResponse
+	(*RedeliverWebhookRequest)(nil),       // 108: blog.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),      // 109: blog.RedeliverWebhookResponse
+	nil,                                   // 110: blog.BlogPost.ReactionCountsEntry
+	nil,                                   // 111: blog.ReactToPostResponse.ReactionCountsEntry
+	nil,                                   // 112: blog.RemoveReactionResponse.ReactionCountsEntry
+	(*timestamppb.Timestamp)(nil),         // 113: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 114: google.protobuf.FieldMask
 }
 var file_blog_proto_depIdxs = []int32{
-	9, // 0: blog.BlogPost.publication_date:type_name -> google.protobuf.Timestamp
-	9, // 1: blog.CreatePostRequest.publication_date:type_name -> google.protobuf.Timestamp
-	0, // 2: blog.CreatePostResponse.post:type_name -> blog.BlogPost
-	0, // 3: blog.ReadPostResponse.post:type_name -> blog.BlogPost
-	0, // 4: blog.UpdatePostResponse.post:type_name -> blog.BlogPost
-	1, // 5: blog.BlogService.CreatePost:input_type -> blog.CreatePostRequest
-	3, // 6: blog.BlogService.ReadPost:input_type -> blog.ReadPostRequest
-	5, // 7: blog.BlogService.UpdatePost:input_type -> blog.UpdatePostRequest
-	7, // 8: blog.BlogService.DeletePost:input_type -> blog.DeletePostRequest
-	2, // 9: blog.BlogService.CreatePost:output_type -> blog.CreatePostResponse
-	4, // 10: blog.BlogService.ReadPost:output_type -> blog.ReadPostResponse
-	6, // 11: blog.BlogService.UpdatePost:output_type -> blog.UpdatePostResponse
-	8, // 12: blog.BlogService.DeletePost:output_type -> blog.DeletePostResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	113, // 0: blog.BlogPost.publication_date:type_name -> google.protobuf.Timestamp
+	110, // 1: blog.BlogPost.reaction_counts:type_name -> blog.BlogPost.ReactionCountsEntry
+	113, // 2: blog.CreatePostRequest.publication_date:type_name -> google.protobuf.Timestamp
+	3,   // 3: blog.CreatePostResponse.post:type_name -> blog.BlogPost
+	6,   // 4: blog.CreatePostResponse.duplicate_warnings:type_name -> blog.DuplicateMatch
+	3,   // 5: blog.ReadPostResponse.post:type_name -> blog.BlogPost
+	71,  // 6: blog.ReadPostResponse.series:type_name -> blog.SeriesNavigation
+	114, // 7: blog.UpdatePostRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,   // 8: blog.UpdatePostResponse.post:type_name -> blog.BlogPost
+	111, // 9: blog.ReactToPostResponse.reaction_counts:type_name -> blog.ReactToPostResponse.ReactionCountsEntry
+	112, // 10: blog.RemoveReactionResponse.reaction_counts:type_name -> blog.RemoveReactionResponse.ReactionCountsEntry
+	113, // 11: blog.GetPostStatsRequest.start_time:type_name -> google.protobuf.Timestamp
+	113, // 12: blog.GetPostStatsRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,   // 13: blog.GetPostStatsRequest.granularity:type_name -> blog.StatsGranularity
+	113, // 14: blog.ViewBucket.start_time:type_name -> google.protobuf.Timestamp
+	20,  // 15: blog.GetPostStatsResponse.buckets:type_name -> blog.ViewBucket
+	3,   // 16: blog.TrendingPost.post:type_name -> blog.BlogPost
+	23,  // 17: blog.ListTrendingPostsResponse.posts:type_name -> blog.TrendingPost
+	3,   // 18: blog.RelatedPost.post:type_name -> blog.BlogPost
+	26,  // 19: blog.GetRelatedPostsResponse.posts:type_name -> blog.RelatedPost
+	113, // 20: blog.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	113, // 21: blog.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	113, // 22: blog.CreateApiKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	28,  // 23: blog.CreateApiKeyResponse.api_key:type_name -> blog.ApiKey
+	28,  // 24: blog.ListApiKeysResponse.api_keys:type_name -> blog.ApiKey
+	113, // 25: blog.Comment.created_at:type_name -> google.protobuf.Timestamp
+	113, // 26: blog.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	35,  // 27: blog.Comment.replies:type_name -> blog.Comment
+	1,   // 28: blog.Comment.moderation_state:type_name -> blog.ModerationState
+	35,  // 29: blog.AddCommentResponse.comment:type_name -> blog.Comment
+	35,  // 30: blog.ListCommentsResponse.comments:type_name -> blog.Comment
+	35,  // 31: blog.EditCommentResponse.comment:type_name -> blog.Comment
+	1,   // 32: blog.ListModerationQueueRequest.state:type_name -> blog.ModerationState
+	35,  // 33: blog.ListModerationQueueResponse.comments:type_name -> blog.Comment
+	1,   // 34: blog.ModerateCommentRequest.state:type_name -> blog.ModerationState
+	35,  // 35: blog.ModerateCommentResponse.comment:type_name -> blog.Comment
+	49,  // 36: blog.FindDuplicatesResponse.pairs:type_name -> blog.DuplicatePair
+	51,  // 37: blog.ListTagsResponse.tags:type_name -> blog.TagCount
+	59,  // 38: blog.SuggestTagsResponse.suggestions:type_name -> blog.TagSuggestion
+	61,  // 39: blog.CreateCategoryResponse.category:type_name -> blog.Category
+	61,  // 40: blog.MoveCategoryResponse.category:type_name -> blog.Category
+	61,  // 41: blog.ListCategoriesResponse.categories:type_name -> blog.Category
+	3,   // 42: blog.ListCategoryPostsResponse.posts:type_name -> blog.BlogPost
+	70,  // 43: blog.CreateSeriesResponse.series:type_name -> blog.Series
+	70,  // 44: blog.AddPostToSeriesResponse.series:type_name -> blog.Series
+	70,  // 45: blog.ReorderSeriesResponse.series:type_name -> blog.Series
+	78,  // 46: blog.Author.social_links:type_name -> blog.SocialLink
+	78,  // 47: blog.CreateAuthorRequest.social_links:type_name -> blog.SocialLink
+	79,  // 48: blog.CreateAuthorResponse.author:type_name -> blog.Author
+	79,  // 49: blog.GetAuthorResponse.author:type_name -> blog.Author
+	78,  // 50: blog.UpdateAuthorRequest.social_links:type_name -> blog.SocialLink
+	79,  // 51: blog.UpdateAuthorResponse.author:type_name -> blog.Author
+	79,  // 52: blog.ListAuthorsResponse.authors:type_name -> blog.Author
+	3,   // 53: blog.GetHomeFeedResponse.posts:type_name -> blog.BlogPost
+	113, // 54: blog.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 55: blog.WebhookDelivery.state:type_name -> blog.DeliveryState
+	113, // 56: blog.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	113, // 57: blog.WebhookDelivery.last_attempt_at:type_name -> google.protobuf.Timestamp
+	113, // 58: blog.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	96,  // 59: blog.CreateWebhookResponse.webhook:type_name -> blog.Webhook
+	96,  // 60: blog.ListWebhooksResponse.webhooks:type_name -> blog.Webhook
+	97,  // 61: blog.ListWebhookDeliveriesResponse.deliveries:type_name -> blog.WebhookDelivery
+	97,  // 62: blog.ListDeadLettersResponse.deliveries:type_name -> blog.WebhookDelivery
+	97,  // 63: blog.RedeliverWebhookResponse.delivery:type_name -> blog.WebhookDelivery
+	4,   // 64: blog.BlogService.CreatePost:input_type -> blog.CreatePostRequest
+	7,   // 65: blog.BlogService.ReadPost:input_type -> blog.ReadPostRequest
+	9,   // 66: blog.BlogService.UpdatePost:input_type -> blog.UpdatePostRequest
+	11,  // 67: blog.BlogService.DeletePost:input_type -> blog.DeletePostRequest
+	13,  // 68: blog.BlogService.ReactToPost:input_type -> blog.ReactToPostRequest
+	15,  // 69: blog.BlogService.RemoveReaction:input_type -> blog.RemoveReactionRequest
+	17,  // 70: blog.BlogService.RecordView:input_type -> blog.RecordViewRequest
+	19,  // 71: blog.BlogService.GetPostStats:input_type -> blog.GetPostStatsRequest
+	22,  // 72: blog.BlogService.ListTrendingPosts:input_type -> blog.ListTrendingPostsRequest
+	25,  // 73: blog.BlogService.GetRelatedPosts:input_type -> blog.GetRelatedPostsRequest
+	36,  // 74: blog.CommentService.AddComment:input_type -> blog.AddCommentRequest
+	38,  // 75: blog.CommentService.ListComments:input_type -> blog.ListCommentsRequest
+	40,  // 76: blog.CommentService.EditComment:input_type -> blog.EditCommentRequest
+	42,  // 77: blog.CommentService.DeleteComment:input_type -> blog.DeleteCommentRequest
+	44,  // 78: blog.CommentService.ListModerationQueue:input_type -> blog.ListModerationQueueRequest
+	46,  // 79: blog.CommentService.ModerateComment:input_type -> blog.ModerateCommentRequest
+	52,  // 80: blog.TagService.ListTags:input_type -> blog.ListTagsRequest
+	54,  // 81: blog.TagService.RenameTag:input_type -> blog.RenameTagRequest
+	56,  // 82: blog.TagService.MergeTags:input_type -> blog.MergeTagsRequest
+	58,  // 83: blog.TagService.SuggestTags:input_type -> blog.SuggestTagsRequest
+	62,  // 84: blog.CategoryService.CreateCategory:input_type -> blog.CreateCategoryRequest
+	64,  // 85: blog.CategoryService.MoveCategory:input_type -> blog.MoveCategoryRequest
+	66,  // 86: blog.CategoryService.ListCategories:input_type -> blog.ListCategoriesRequest
+	68,  // 87: blog.CategoryService.ListCategoryPosts:input_type -> blog.ListCategoryPostsRequest
+	72,  // 88: blog.SeriesService.CreateSeries:input_type -> blog.CreateSeriesRequest
+	74,  // 89: blog.SeriesService.AddPostToSeries:input_type -> blog.AddPostToSeriesRequest
+	76,  // 90: blog.SeriesService.ReorderSeries:input_type -> blog.ReorderSeriesRequest
+	80,  // 91: blog.AuthorService.CreateAuthor:input_type -> blog.CreateAuthorRequest
+	82,  // 92: blog.AuthorService.GetAuthor:input_type -> blog.GetAuthorRequest
+	84,  // 93: blog.AuthorService.UpdateAuthor:input_type -> blog.UpdateAuthorRequest
+	86,  // 94: blog.AuthorService.ListAuthors:input_type -> blog.ListAuthorsRequest
+	88,  // 95: blog.FeedService.Follow:input_type -> blog.FollowRequest
+	90,  // 96: blog.FeedService.Unfollow:input_type -> blog.UnfollowRequest
+	92,  // 97: blog.FeedService.ListFollowing:input_type -> blog.ListFollowingRequest
+	94,  // 98: blog.FeedService.GetHomeFeed:input_type -> blog.GetHomeFeedRequest
+	98,  // 99: blog.WebhookService.CreateWebhook:input_type -> blog.CreateWebhookRequest
+	100, // 100: blog.WebhookService.ListWebhooks:input_type -> blog.ListWebhooksRequest
+	102, // 101: blog.WebhookService.DeleteWebhook:input_type -> blog.DeleteWebhookRequest
+	104, // 102: blog.WebhookService.ListWebhookDeliveries:input_type -> blog.ListWebhookDeliveriesRequest
+	106, // 103: blog.WebhookService.ListDeadLetters:input_type -> blog.ListDeadLettersRequest
+	108, // 104: blog.WebhookService.RedeliverWebhook:input_type -> blog.RedeliverWebhookRequest
+	29,  // 105: blog.AdminService.CreateApiKey:input_type -> blog.CreateApiKeyRequest
+	31,  // 106: blog.AdminService.ListApiKeys:input_type -> blog.ListApiKeysRequest
+	33,  // 107: blog.AdminService.RevokeApiKey:input_type -> blog.RevokeApiKeyRequest
+	48,  // 108: blog.AdminService.FindDuplicates:input_type -> blog.FindDuplicatesRequest
+	5,   // 109: blog.BlogService.CreatePost:output_type -> blog.CreatePostResponse
+	8,   // 110: blog.BlogService.ReadPost:output_type -> blog.ReadPostResponse
+	10,  // 111: blog.BlogService.UpdatePost:output_type -> blog.UpdatePostResponse
+	12,  // 112: blog.BlogService.DeletePost:output_type -> blog.DeletePostResponse
+	14,  // 113: blog.BlogService.ReactToPost:output_type -> blog.ReactToPostResponse
+	16,  // 114: blog.BlogService.RemoveReaction:output_type -> blog.RemoveReactionResponse
+	18,  // 115: blog.BlogService.RecordView:output_type -> blog.RecordViewResponse
+	21,  // 116: blog.BlogService.GetPostStats:output_type -> blog.GetPostStatsResponse
+	24,  // 117: blog.BlogService.ListTrendingPosts:output_type -> blog.ListTrendingPostsResponse
+	27,  // 118: blog.BlogService.GetRelatedPosts:output_type -> blog.GetRelatedPostsResponse
+	37,  // 119: blog.CommentService.AddComment:output_type -> blog.AddCommentResponse
+	39,  // 120: blog.CommentService.ListComments:output_type -> blog.ListCommentsResponse
+	41,  // 121: blog.CommentService.EditComment:output_type -> blog.EditCommentResponse
+	43,  // 122: blog.CommentService.DeleteComment:output_type -> blog.DeleteCommentResponse
+	45,  // 123: blog.CommentService.ListModerationQueue:output_type -> blog.ListModerationQueueResponse
+	47,  // 124: blog.CommentService.ModerateComment:output_type -> blog.ModerateCommentResponse
+	53,  // 125: blog.TagService.ListTags:output_type -> blog.ListTagsResponse
+	55,  // 126: blog.TagService.RenameTag:output_type -> blog.RenameTagResponse
+	57,  // 127: blog.TagService.MergeTags:output_type -> blog.MergeTagsResponse
+	60,  // 128: blog.TagService.SuggestTags:output_type -> blog.SuggestTagsResponse
+	63,  // 129: blog.CategoryService.CreateCategory:output_type -> blog.CreateCategoryResponse
+	65,  // 130: blog.CategoryService.MoveCategory:output_type -> blog.MoveCategoryResponse
+	67,  // 131: blog.CategoryService.ListCategories:output_type -> blog.ListCategoriesResponse
+	69,  // 132: blog.CategoryService.ListCategoryPosts:output_type -> blog.ListCategoryPostsResponse
+	73,  // 133: blog.SeriesService.CreateSeries:output_type -> blog.CreateSeriesResponse
+	75,  // 134: blog.SeriesService.AddPostToSeries:output_type -> blog.AddPostToSeriesResponse
+	77,  // 135: blog.SeriesService.ReorderSeries:output_type -> blog.ReorderSeriesResponse
+	81,  // 136: blog.AuthorService.CreateAuthor:output_type -> blog.CreateAuthorResponse
+	83,  // 137: blog.AuthorService.GetAuthor:output_type -> blog.GetAuthorResponse
+	85,  // 138: blog.AuthorService.UpdateAuthor:output_type -> blog.UpdateAuthorResponse
+	87,  // 139: blog.AuthorService.ListAuthors:output_type -> blog.ListAuthorsResponse
+	89,  // 140: blog.FeedService.Follow:output_type -> blog.FollowResponse
+	91,  // 141: blog.FeedService.Unfollow:output_type -> blog.UnfollowResponse
+	93,  // 142: blog.FeedService.ListFollowing:output_type -> blog.ListFollowingResponse
+	95,  // 143: blog.FeedService.GetHomeFeed:output_type -> blog.GetHomeFeedResponse
+	99,  // 144: blog.WebhookService.CreateWebhook:output_type -> blog.CreateWebhookResponse
+	101, // 145: blog.WebhookService.ListWebhooks:output_type -> blog.ListWebhooksResponse
+	103, // 146: blog.WebhookService.DeleteWebhook:output_type -> blog.DeleteWebhookResponse
+	105, // 147: blog.WebhookService.ListWebhookDeliveries:output_type -> blog.ListWebhookDeliveriesResponse
+	107, // 148: blog.WebhookService.ListDeadLetters:output_type -> blog.ListDeadLettersResponse
+	109, // 149: blog.WebhookService.RedeliverWebhook:output_type -> blog.RedeliverWebhookResponse
+	30,  // 150: blog.AdminService.CreateApiKey:output_type -> blog.CreateApiKeyResponse
+	32,  // 151: blog.AdminService.ListApiKeys:output_type -> blog.ListApiKeysResponse
+	34,  // 152: blog.AdminService.RevokeApiKey:output_type -> blog.RevokeApiKeyResponse
+	50,  // 153: blog.AdminService.FindDuplicates:output_type -> blog.FindDuplicatesResponse
+	109, // [109:154] is the sub-list for method output_type
+	64,  // [64:109] is the sub-list for method input_type
+	64,  // [64:64] is the sub-list for extension type_name
+	64,  // [64:64] is the sub-list for extension extendee
+	0,   // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_blog_proto_init() }
@@ -655,18 +7303,20 @@ func file_blog_proto_init() {
 	if File_blog_proto != nil {
 		return
 	}
+	file_validate_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_blog_proto_rawDesc), len(file_blog_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   110,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   9,
 		},
 		GoTypes:           file_blog_proto_goTypes,
 		DependencyIndexes: file_blog_proto_depIdxs,
+		EnumInfos:         file_blog_proto_enumTypes,
 		MessageInfos:      file_blog_proto_msgTypes,
 	}.Build()
 	File_blog_proto = out.File